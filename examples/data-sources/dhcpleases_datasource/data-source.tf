@@ -0,0 +1,9 @@
+
+data "provision6connect_dhcp_leases" "pool_leases" {
+  pool_id = "799420"
+  state = "active"
+}
+
+output "pool_leases" {
+  value = data.provision6connect_dhcp_leases.pool_leases.leases
+}
@@ -0,0 +1,10 @@
+
+data "provision6connect_dhcp_pool_utilization" "server_pools" {
+  server_id = "799418"
+}
+
+output "pool_percent_used" {
+  value = {
+    for pool in data.provision6connect_dhcp_pool_utilization.server_pools.pools : pool.name => pool.percent_used
+  }
+}
@@ -0,0 +1,9 @@
+
+data "provision6connect_dnsrecords" "mx" {
+  zone_id = "428964"
+  record_type = "MX"
+}
+
+output "mx_records" {
+  value = data.provision6connect_dnsrecords.mx.records
+}
@@ -0,0 +1,17 @@
+
+data "provision6connect_dnszone" "shared" {
+  name = "shared.example.com."
+}
+
+resource "provision6connect_dnsrecord" "pvrecord" {
+  zone_id = data.provision6connect_dnszone.shared.id
+  name = "TerraForm Record"
+  record_host = "terraform.shared.example.com."
+  record_value = "111.111.111.112"
+  record_type = "A"
+  record_ttl = "900"
+}
+
+output "shared_zone" {
+  value = data.provision6connect_dnszone.shared
+}
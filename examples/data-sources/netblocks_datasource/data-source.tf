@@ -9,3 +9,15 @@ data "provision6connect_netblocks" "someblock" {
 output "kubs_sss" {
   value = data.provision6connect_netblocks.someblock
 }
+
+data "provision6connect_netblocks" "assignments" {
+  search = {
+    "mask" = "32"
+    "assigned" = "1"
+  }
+  max_results = 50000
+}
+
+output "assignment_count" {
+  value = length(data.provision6connect_netblocks.assignments.netblocks)
+}
@@ -0,0 +1,14 @@
+
+data "provision6connect_resource" "customer" {
+  slug = "acme-corp"
+}
+
+data "provision6connect_resource" "site" {
+  name = "Site 1"
+  type = "entry"
+  parent_id = data.provision6connect_resource.customer.id
+}
+
+output "site_parents" {
+  value = data.provision6connect_resource.site.parents[*].name
+}
@@ -9,3 +9,17 @@ data "provision6connect_resources" "kubs" {
 output "kubs_resources" {
   value = data.provision6connect_resources.kubs
 }
+
+data "provision6connect_resources" "customers" {
+  type = "customer"
+  parent_id = "1234"
+  attrs = {
+    "status" = "active"
+  }
+  limit = 100
+  offset = 0
+}
+
+output "customer_names" {
+  value = data.provision6connect_resources.customers.resources[*].name
+}
@@ -0,0 +1,10 @@
+
+data "provision6connect_resource_tree" "customer" {
+  root_id = "1234"
+  max_depth = 3
+  include_netblocks = true
+}
+
+output "customer_netblocks" {
+  value = flatten([for node in data.provision6connect_resource_tree.customer.nodes : node.netblocks[*].cidr])
+}
@@ -0,0 +1,8 @@
+
+data "provision6connect_zonefile" "backup" {
+  zone_id = "799430"
+}
+
+output "zone_backup" {
+  value = data.provision6connect_zonefile.backup.content
+}
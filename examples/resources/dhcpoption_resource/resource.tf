@@ -0,0 +1,24 @@
+
+resource "provision6connect_dhcp_option" "routers" {
+  scope_id = "799420"
+  name = "routers"
+  value = "10.0.0.1,10.0.0.2"
+}
+
+resource "provision6connect_dhcp_option" "nextserver" {
+  scope_id = "799420"
+  name = "next-server"
+  value = "10.0.0.10"
+}
+
+resource "provision6connect_dhcp_option" "custom" {
+  scope_id = "799420"
+  code = 224
+  name = "site-local-config"
+  type = "hex"
+  value = "0a:0b:0c"
+}
+
+output "dhcp_routers" {
+  value = provision6connect_dhcp_option.routers
+}
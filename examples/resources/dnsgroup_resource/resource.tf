@@ -0,0 +1,23 @@
+
+resource "provision6connect_dns_server" "ns1" {
+  name = "ns1.tfexample.com"
+  server_type = "powerdns"
+  host = "192.0.2.53"
+  port = 8081
+  connection_type = "api"
+  api_key = var.pdns_api_key
+}
+
+resource "provision6connect_dns_group" "public" {
+  name = "Public DNS"
+  server_ids = [provision6connect_dns_server.ns1.id]
+}
+
+resource "provision6connect_dnszone" "tfexample" {
+  name = "tfexample.com."
+  group_id = provision6connect_dns_group.public.id
+}
+
+output "pv_dns_group" {
+  value = provision6connect_dns_group.public
+}
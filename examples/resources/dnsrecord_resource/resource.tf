@@ -9,6 +9,39 @@ resource "provision6connect_dnsrecord" "pvrecord" {
   
 }
 
+resource "provision6connect_dnsrecord" "pvrecord_ptr" {
+  zone_id = "428964"
+  name = "TerraForm Record 3"
+  record_host = "terraform3.6ckubs.com."
+  record_value = "192.0.2.70"
+  record_type = "A"
+  record_ttl = "900"
+  create_ptr = true
+}
+
 output "pv_record" {
   value = provision6connect_dnsrecord.pvrecord
 }
+
+resource "provision6connect_dnsrecord" "pvrecord_srv" {
+  zone_id = "428964"
+  name = "TerraForm SIP Record"
+  record_host = "_sip._tcp.6ckubs.com."
+  record_type = "SRV"
+  record_ttl = "900"
+  srv = {
+    priority = 10
+    weight = 5
+    port = 5060
+    target = "sip.6ckubs.com."
+  }
+}
+
+resource "provision6connect_dnsrecord" "pvrecord_by_name" {
+  zone_name = "6ckubs.com."
+  name = "TerraForm Record 4"
+  record_host = "terraform4.6ckubs.com."
+  record_value = "192.0.2.71"
+  record_type = "A"
+  record_ttl = "900"
+}
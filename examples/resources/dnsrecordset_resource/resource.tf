@@ -0,0 +1,23 @@
+
+resource "provision6connect_dns_recordset" "www" {
+  zone_id = "428964"
+  record_host = "www.6ckubs.com."
+  record_type = "A"
+  record_ttl = 300
+  values = ["192.0.2.10", "192.0.2.11", "192.0.2.12"]
+}
+
+resource "provision6connect_dns_recordset" "txt" {
+  zone_id = "428964"
+  record_host = "6ckubs.com."
+  record_type = "TXT"
+  record_ttl = 3600
+  values = [
+    "v=spf1 include:_spf.6ckubs.com ~all",
+    "google-site-verification=abc123",
+  ]
+}
+
+output "pv_recordset" {
+  value = provision6connect_dns_recordset.www
+}
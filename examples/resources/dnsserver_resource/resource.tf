@@ -0,0 +1,15 @@
+
+resource "provision6connect_dns_server" "ns1" {
+  name = "ns1.tfexample.com"
+  server_type = "bind"
+  host = "192.0.2.53"
+  port = 22
+  connection_type = "ssh"
+  username = "provision"
+  password = var.ns1_password
+  config_path = "/etc/bind"
+}
+
+output "pv_dns_server" {
+  value = provision6connect_dns_server.ns1.id
+}
@@ -2,8 +2,26 @@
 resource "provision6connect_dnszone" "tfexample" {
   name = "tfexample.com."
   group_id = "799411"
+  serial_policy = "date"
+  dnssec_enabled = true
+  dnssec_algorithm = "ECDSAP256SHA256"
+  dnssec_ksk_rollover_days = 365
+  dnssec_zsk_rollover_days = 30
+}
+
+resource "provision6connect_dnszone" "tfexample_internal" {
+  name = "tfexample.net."
+  view = "internal"
+  zone_role = "secondary"
+  masters = ["192.0.2.53"]
+  allow_query = ["10.0.0.0/8", "localnets"]
+  allow_transfer = ["none"]
 }
 
 output "pv_zone" {
   value = provision6connect_dnszone.tfexample
 }
+
+output "pv_zone_ds_records" {
+  value = provision6connect_dnszone.tfexample.ds_records
+}
@@ -0,0 +1,33 @@
+
+resource "provision6connect_dnszone" "tfexample" {
+  name = "tfexample.com."
+}
+
+resource "provision6connect_dnszone_records" "tfexample" {
+  zone_id = provision6connect_dnszone.tfexample.id
+  records = [
+    {
+      record_type = "A"
+      record_host = "tfexample.com."
+      record_value = "192.0.2.10"
+      record_ttl = 3600
+    },
+    {
+      record_type = "CNAME"
+      record_host = "www.tfexample.com."
+      record_value = "tfexample.com."
+      record_ttl = 3600
+    },
+    {
+      name = "Mail server"
+      record_type = "MX"
+      record_host = "tfexample.com."
+      record_value = "10 mail.tfexample.com."
+      record_ttl = 3600
+    },
+  ]
+}
+
+output "pv_zone_records" {
+  value = provision6connect_dnszone_records.tfexample
+}
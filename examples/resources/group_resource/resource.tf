@@ -0,0 +1,9 @@
+
+resource "provision6connect_group" "acme_noc" {
+  name = "ACME NOC"
+  description = "Network operations team of ACME"
+}
+
+output "acme_noc_group" {
+  value = provision6connect_group.acme_noc
+}
@@ -3,6 +3,8 @@ resource "provision6connect_resource" "pvrecord" {
   parent_id = "428964"
   type = "dnsrecord"
   name = "TerraForm Record"
+  slug = "terraform-record"
+  allow_reparent = true
   attrs = {
     "record_host" = "terraform23.6ckubs.com."
     "record_value" = "111.111.111.112"
@@ -11,6 +13,12 @@ resource "provision6connect_resource" "pvrecord" {
   }
 }
 
+# Renaming the Terraform address keeps the ProVision Resource
+moved {
+  from = provision6connect_resource.record
+  to = provision6connect_resource.pvrecord
+}
+
 output "pv_record" {
   value = provision6connect_resource.pvrecord
 }
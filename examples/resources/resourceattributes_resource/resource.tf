@@ -0,0 +1,18 @@
+
+resource "provision6connect_resource" "customer" {
+  parent_id = "428964"
+  type = "entry"
+  name = "TerraForm Customer"
+}
+
+resource "provision6connect_resource_attributes" "customer_billing" {
+  resource_id = provision6connect_resource.customer.id
+  attrs = {
+    "billing_contact" = "billing@example.com"
+    "cost_center" = "1042"
+  }
+}
+
+output "pv_resource_attributes" {
+  value = provision6connect_resource_attributes.customer_billing
+}
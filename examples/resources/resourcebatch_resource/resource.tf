@@ -0,0 +1,31 @@
+
+locals {
+  sites = {
+    "nyc1" = "New York 1"
+    "lax1" = "Los Angeles 1"
+    "ord1" = "Chicago 1"
+  }
+}
+
+resource "provision6connect_resource_batch" "sites" {
+  concurrency = 8
+  resources = {
+    for key, name in local.sites : key => {
+      name = name
+      type = "entry"
+      parent_id = "1234"
+      slug = "site-${key}"
+      attrs = {
+        "site_code" = upper(key)
+      }
+    }
+  }
+}
+
+output "site_ids" {
+  value = { for key, site in provision6connect_resource_batch.sites.resources : key => site.id }
+}
+
+output "site_failures" {
+  value = provision6connect_resource_batch.sites.failures
+}
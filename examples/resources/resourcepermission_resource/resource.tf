@@ -0,0 +1,21 @@
+
+resource "provision6connect_resource" "acme" {
+  type = "customer"
+  name = "ACME"
+}
+
+resource "provision6connect_group" "acme_noc" {
+  name = "ACME NOC"
+}
+
+# Grant the team edit access to the customer and everything under it
+resource "provision6connect_resource_permission" "acme_noc" {
+  resource_id = provision6connect_resource.acme.id
+  group_id = provision6connect_group.acme_noc.id
+  access = "edit"
+  recursive = true
+}
+
+output "acme_noc_permission" {
+  value = provision6connect_resource_permission.acme_noc
+}
@@ -0,0 +1,16 @@
+
+resource "provision6connect_reverse_zone" "lan" {
+  cidr = "192.0.2.64/26"
+  group_id = "5123"
+  zone_host = "ns1.tfexample.com."
+  zone_mail = "hostmaster.tfexample.com."
+}
+
+resource "provision6connect_reverse_zone" "testblock" {
+  netblock_id = provision6connect_netblock.testblock.id
+  group_id = "5123"
+}
+
+output "pv_reverse_zones" {
+  value = provision6connect_reverse_zone.lan.zones
+}
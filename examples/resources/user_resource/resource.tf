@@ -0,0 +1,22 @@
+
+variable "jdoe_password" {
+  type = string
+  sensitive = true
+}
+
+resource "provision6connect_group" "acme_noc" {
+  name = "ACME NOC"
+}
+
+resource "provision6connect_user" "jdoe" {
+  username = "jdoe"
+  email = "jdoe@example.com"
+  first_name = "Jane"
+  last_name = "Doe"
+  password = var.jdoe_password
+  group_ids = [provision6connect_group.acme_noc.id]
+}
+
+output "jdoe_user" {
+  value = provision6connect_user.jdoe.id
+}
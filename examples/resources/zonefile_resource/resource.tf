@@ -0,0 +1,20 @@
+
+resource "provision6connect_dnszone" "tfexample" {
+  name = "tfexample.com."
+}
+
+resource "provision6connect_zonefile" "tfexample" {
+  zone_id = provision6connect_dnszone.tfexample.id
+  content = <<-EOT
+    $TTL 3600
+    @        IN A     192.0.2.10
+             IN MX    10 mail
+    www      IN CNAME @
+    mail 300 IN A     192.0.2.25
+    @        IN TXT   "v=spf1 mx -all"
+  EOT
+}
+
+output "pv_zonefile" {
+  value = provision6connect_zonefile.tfexample.record_count
+}
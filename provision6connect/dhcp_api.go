@@ -0,0 +1,94 @@
+package provision6connect
+
+import (
+	"math"
+	"math/big"
+	"net"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// dhcpLease is a DHCP lease as returned by the ProVision DHCP leases API.
+type dhcpLease struct {
+	ID        provisionclient.PVID `json:"id"`
+	PoolID    provisionclient.PVID `json:"pool_id"`
+	ServerID  provisionclient.PVID `json:"server_id"`
+	IPAddress string               `json:"ip_address"`
+	MAC       string               `json:"mac"`
+	Hostname  string               `json:"hostname"`
+	State     string               `json:"state"`
+	Starts    string               `json:"starts"`
+	Ends      string               `json:"ends"`
+}
+
+// dhcpPool is a DHCP pool as returned by the ProVision DHCP pools API.
+type dhcpPool struct {
+	ID         provisionclient.PVID `json:"id"`
+	Name       string               `json:"name"`
+	ServerID   provisionclient.PVID `json:"server_id"`
+	GroupID    provisionclient.PVID `json:"group_id"`
+	RangeStart string               `json:"range_start"`
+	RangeEnd   string               `json:"range_end"`
+}
+
+//	getDHCPLeases(&client.DHCP, &map[string]string{
+//		"pool_id": "799420",
+//	})
+func getDHCPLeases(dhcp *provisionclient.DHCPMethods, filters *map[string]string) ([]dhcpLease, error) {
+	leases := []dhcpLease{}
+	err := apiRequest(dhcp.Client, "GET", "/dhcp/leases", filters, nil, &leases)
+	if err != nil {
+		return nil, err
+	}
+
+	return leases, nil
+}
+
+//	getDHCPPools(&client.DHCP, &map[string]string{
+//		"server_id": "799418",
+//	})
+func getDHCPPools(dhcp *provisionclient.DHCPMethods, filters *map[string]string) ([]dhcpPool, error) {
+	pools := []dhcpPool{}
+	err := apiRequest(dhcp.Client, "GET", "/dhcp/pools", filters, nil, &pools)
+	if err != nil {
+		return nil, err
+	}
+
+	return pools, nil
+}
+
+// normalizeMAC lower cases a MAC address and converts it to the colon separated form
+// so filters match regardless of the notation used in the configuration.
+func normalizeMAC(mac string) string {
+	if hw, err := net.ParseMAC(strings.TrimSpace(mac)); err == nil {
+		return hw.String()
+	}
+	return strings.ToLower(strings.TrimSpace(mac))
+}
+
+// dhcpPoolSize returns the number of addresses between start and end, inclusive.
+// Sizes that do not fit into an int64 (large IPv6 ranges) are capped.
+func dhcpPoolSize(start, end string) int64 {
+	startIP := net.ParseIP(start)
+	endIP := net.ParseIP(end)
+	if startIP == nil || endIP == nil {
+		return 0
+	}
+
+	if startIP.To4() != nil && endIP.To4() != nil {
+		startIP = startIP.To4()
+		endIP = endIP.To4()
+	}
+
+	size := new(big.Int).Sub(new(big.Int).SetBytes(endIP), new(big.Int).SetBytes(startIP))
+	size.Add(size, big.NewInt(1))
+	if size.Sign() <= 0 {
+		return 0
+	}
+	if !size.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return size.Int64()
+}
@@ -0,0 +1,194 @@
+package provision6connect
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// DHCP option value types accepted by the dhcp_option resource.
+const (
+	dhcpOptionTypeIP      = "ip"
+	dhcpOptionTypeIPList  = "ip_list"
+	dhcpOptionTypeString  = "string"
+	dhcpOptionTypeInteger = "integer"
+	dhcpOptionTypeBoolean = "boolean"
+	dhcpOptionTypeHex     = "hex"
+)
+
+var dhcpOptionTypes = []string{
+	dhcpOptionTypeIP,
+	dhcpOptionTypeIPList,
+	dhcpOptionTypeString,
+	dhcpOptionTypeInteger,
+	dhcpOptionTypeBoolean,
+	dhcpOptionTypeHex,
+}
+
+// dhcpOptionDefinition describes a well known DHCP option. Code 0 is used for
+// server statements like next-server that are configured alongside the options
+// but do not have an option code on the wire.
+type dhcpOptionDefinition struct {
+	Code int64
+	Name string
+	Type string
+	Min  int64
+	Max  int64
+}
+
+// dhcpKnownOptions lists the standard DHCPv4 options (RFC 2132 and friends)
+// that ProVision manages on servers, groups, pools and hosts.
+var dhcpKnownOptions = []dhcpOptionDefinition{
+	{Code: 0, Name: "next-server", Type: dhcpOptionTypeIP},
+	{Code: 0, Name: "filename", Type: dhcpOptionTypeString},
+	{Code: 1, Name: "subnet-mask", Type: dhcpOptionTypeIP},
+	{Code: 2, Name: "time-offset", Type: dhcpOptionTypeInteger, Min: -2147483648, Max: 2147483647},
+	{Code: 3, Name: "routers", Type: dhcpOptionTypeIPList},
+	{Code: 4, Name: "time-servers", Type: dhcpOptionTypeIPList},
+	{Code: 6, Name: "domain-name-servers", Type: dhcpOptionTypeIPList},
+	{Code: 7, Name: "log-servers", Type: dhcpOptionTypeIPList},
+	{Code: 12, Name: "host-name", Type: dhcpOptionTypeString},
+	{Code: 15, Name: "domain-name", Type: dhcpOptionTypeString},
+	{Code: 19, Name: "ip-forwarding", Type: dhcpOptionTypeBoolean},
+	{Code: 23, Name: "default-ip-ttl", Type: dhcpOptionTypeInteger, Min: 1, Max: 255},
+	{Code: 26, Name: "interface-mtu", Type: dhcpOptionTypeInteger, Min: 68, Max: 65535},
+	{Code: 28, Name: "broadcast-address", Type: dhcpOptionTypeIP},
+	{Code: 33, Name: "static-routes", Type: dhcpOptionTypeIPList},
+	{Code: 42, Name: "ntp-servers", Type: dhcpOptionTypeIPList},
+	{Code: 43, Name: "vendor-encapsulated-options", Type: dhcpOptionTypeHex},
+	{Code: 44, Name: "netbios-name-servers", Type: dhcpOptionTypeIPList},
+	{Code: 46, Name: "netbios-node-type", Type: dhcpOptionTypeInteger, Min: 1, Max: 8},
+	{Code: 51, Name: "dhcp-lease-time", Type: dhcpOptionTypeInteger, Min: 0, Max: 4294967295},
+	{Code: 54, Name: "dhcp-server-identifier", Type: dhcpOptionTypeIP},
+	{Code: 58, Name: "dhcp-renewal-time", Type: dhcpOptionTypeInteger, Min: 0, Max: 4294967295},
+	{Code: 59, Name: "dhcp-rebinding-time", Type: dhcpOptionTypeInteger, Min: 0, Max: 4294967295},
+	{Code: 60, Name: "vendor-class-identifier", Type: dhcpOptionTypeString},
+	{Code: 66, Name: "tftp-server-name", Type: dhcpOptionTypeString},
+	{Code: 67, Name: "bootfile-name", Type: dhcpOptionTypeString},
+	{Code: 119, Name: "domain-search", Type: dhcpOptionTypeString},
+	{Code: 121, Name: "classless-static-route", Type: dhcpOptionTypeHex},
+	{Code: 125, Name: "vivso", Type: dhcpOptionTypeHex},
+	{Code: 150, Name: "tftp-server-address", Type: dhcpOptionTypeIPList},
+	{Code: 252, Name: "wpad-url", Type: dhcpOptionTypeString},
+}
+
+// lookupDHCPOptionByCode returns the known definition for an option code.
+func lookupDHCPOptionByCode(code int64) (dhcpOptionDefinition, bool) {
+	if code == 0 {
+		return dhcpOptionDefinition{}, false
+	}
+	for _, option := range dhcpKnownOptions {
+		if option.Code == code {
+			return option, true
+		}
+	}
+	return dhcpOptionDefinition{}, false
+}
+
+// lookupDHCPOptionByName returns the known definition for an option name.
+func lookupDHCPOptionByName(name string) (dhcpOptionDefinition, bool) {
+	for _, option := range dhcpKnownOptions {
+		if option.Name == strings.ToLower(name) {
+			return option, true
+		}
+	}
+	return dhcpOptionDefinition{}, false
+}
+
+// resolveDHCPOption merges the configured code, name and type with the known
+// option table. Unknown codes are accepted as custom options as long as the
+// value type is provided.
+func resolveDHCPOption(code int64, name, optionType string) (dhcpOptionDefinition, error) {
+	var byCode, byName dhcpOptionDefinition
+	var codeKnown, nameKnown bool
+
+	if code != 0 {
+		if code < 1 || code > 254 {
+			return dhcpOptionDefinition{}, fmt.Errorf("option code %d is out of range, it must be between 1 and 254", code)
+		}
+		byCode, codeKnown = lookupDHCPOptionByCode(code)
+	}
+	if name != "" {
+		byName, nameKnown = lookupDHCPOptionByName(name)
+	}
+
+	if codeKnown && name != "" && byCode.Name != strings.ToLower(name) {
+		return dhcpOptionDefinition{}, fmt.Errorf("option code %d is %q, not %q", code, byCode.Name, name)
+	}
+	if nameKnown && code != 0 && byName.Code != code {
+		return dhcpOptionDefinition{}, fmt.Errorf("option %q uses code %d, not %d", byName.Name, byName.Code, code)
+	}
+
+	var definition dhcpOptionDefinition
+	switch {
+	case codeKnown:
+		definition = byCode
+	case nameKnown:
+		definition = byName
+	case code != 0:
+		if optionType == "" {
+			return dhcpOptionDefinition{}, fmt.Errorf("option code %d is not a standard option, type must be set for custom options", code)
+		}
+		definition = dhcpOptionDefinition{Code: code, Name: name, Type: optionType}
+		if definition.Name == "" {
+			definition.Name = "option-" + strconv.FormatInt(code, 10)
+		}
+	case name != "":
+		return dhcpOptionDefinition{}, fmt.Errorf("option %q is not a known option name, custom options must set code", name)
+	default:
+		return dhcpOptionDefinition{}, fmt.Errorf("either code or name must be set")
+	}
+
+	if optionType != "" && optionType != definition.Type {
+		return dhcpOptionDefinition{}, fmt.Errorf("option %q holds %s values, not %s", definition.Name, definition.Type, optionType)
+	}
+
+	return definition, nil
+}
+
+// validateDHCPOptionValue checks the value against the option type.
+func validateDHCPOptionValue(definition dhcpOptionDefinition, value string) error {
+	switch definition.Type {
+	case dhcpOptionTypeIP:
+		if ip := net.ParseIP(strings.TrimSpace(value)); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%q is not a valid IPv4 address", value)
+		}
+	case dhcpOptionTypeIPList:
+		for _, item := range strings.Split(value, ",") {
+			if ip := net.ParseIP(strings.TrimSpace(item)); ip == nil || ip.To4() == nil {
+				return fmt.Errorf("%q is not a valid IPv4 address", strings.TrimSpace(item))
+			}
+		}
+	case dhcpOptionTypeInteger:
+		number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not a valid integer", value)
+		}
+		if (definition.Min != 0 || definition.Max != 0) && (number < definition.Min || number > definition.Max) {
+			return fmt.Errorf("%d is out of range for %s, it must be between %d and %d", number, definition.Name, definition.Min, definition.Max)
+		}
+	case dhcpOptionTypeBoolean:
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "true", "false", "on", "off", "1", "0":
+		default:
+			return fmt.Errorf("%q is not a valid boolean, use true or false", value)
+		}
+	case dhcpOptionTypeHex:
+		raw := strings.ReplaceAll(strings.TrimSpace(value), ":", "")
+		if raw == "" {
+			return fmt.Errorf("hex value must not be empty")
+		}
+		if _, err := hex.DecodeString(raw); err != nil {
+			return fmt.Errorf("%q is not a valid hex string, use 0a:0b:0c or 0a0b0c", value)
+		}
+	case dhcpOptionTypeString:
+		if value == "" {
+			return fmt.Errorf("string value must not be empty")
+		}
+	default:
+		return fmt.Errorf("unsupported option type %q, it must be one of %s", definition.Type, strings.Join(dhcpOptionTypes, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package provision6connect
+
+import (
+	"context"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dhcpleasesDataSourceModel maps the data source schema data.
+type dhcpleasesDataSourceModel struct {
+	PoolID   types.String      `tfsdk:"pool_id"`
+	ServerID types.String      `tfsdk:"server_id"`
+	MAC      types.String      `tfsdk:"mac"`
+	State    types.String      `tfsdk:"state"`
+	Leases   []dhcpleasesModel `tfsdk:"leases"`
+}
+
+// dhcpleasesModel maps DHCP lease schema data.
+type dhcpleasesModel struct {
+	ID        types.String `tfsdk:"id"`
+	PoolID    types.String `tfsdk:"pool_id"`
+	ServerID  types.String `tfsdk:"server_id"`
+	IPAddress types.String `tfsdk:"ip_address"`
+	MAC       types.String `tfsdk:"mac"`
+	Hostname  types.String `tfsdk:"hostname"`
+	State     types.String `tfsdk:"state"`
+	Starts    types.String `tfsdk:"starts"`
+	Ends      types.String `tfsdk:"ends"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dhcpleasesDataSource{}
+	_ datasource.DataSourceWithConfigure = &dhcpleasesDataSource{}
+)
+
+// NewDHCPleasesDataSource is a helper function to simplify the provider implementation.
+func NewDHCPleasesDataSource() datasource.DataSource {
+	return &dhcpleasesDataSource{}
+}
+
+// dhcpleasesDataSource is the data source implementation.
+type dhcpleasesDataSource struct {
+	client *provisionclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dhcpleasesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_leases"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dhcpleasesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *dhcpleasesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DHCP Leases Data Source for query the leases handed out by ProVision DHCP servers, filtered by pool, server and MAC address",
+		Attributes: map[string]schema.Attribute{
+			"pool_id": schema.StringAttribute{
+				Description: "Pool Resource ID to filter the leases by",
+				Optional:    true,
+			},
+			"server_id": schema.StringAttribute{
+				Description: "Server Resource ID to filter the leases by",
+				Optional:    true,
+			},
+			"mac": schema.StringAttribute{
+				Description: "MAC Address to filter the leases by Ex: 00:11:22:33:44:55",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Lease State to filter the leases by Ex: active, free, expired",
+				Optional:    true,
+			},
+			"leases": schema.ListNestedAttribute{
+				Description: "Contains a list of the DHCP Leases found by the search query",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the Lease",
+							Computed:    true,
+						},
+						"pool_id": schema.StringAttribute{
+							Description: "Pool Resource ID the lease belongs to",
+							Computed:    true,
+						},
+						"server_id": schema.StringAttribute{
+							Description: "Server Resource ID that handed out the lease",
+							Computed:    true,
+						},
+						"ip_address": schema.StringAttribute{
+							Description: "Leased IP Address",
+							Computed:    true,
+						},
+						"mac": schema.StringAttribute{
+							Description: "MAC Address of the client",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Hostname sent by the client",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Current Lease State : active, free, expired, abandoned",
+							Computed:    true,
+						},
+						"starts": schema.StringAttribute{
+							Description: "Date and Time the lease starts",
+							Computed:    true,
+						},
+						"ends": schema.StringAttribute{
+							Description: "Date and Time the lease ends",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dhcpleasesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dhcpleasesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := map[string]string{}
+	if !state.PoolID.IsNull() {
+		filters["pool_id"] = state.PoolID.ValueString()
+	}
+	if !state.ServerID.IsNull() {
+		filters["server_id"] = state.ServerID.ValueString()
+	}
+	if !state.MAC.IsNull() {
+		filters["mac"] = normalizeMAC(state.MAC.ValueString())
+	}
+	if !state.State.IsNull() {
+		filters["state"] = state.State.ValueString()
+	}
+
+	leases, err := getDHCPLeases(&d.client.DHCP, &filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision DHCP Leases",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model, the filters are applied again in case the
+	// server ignored any of them.
+	for _, lease := range leases {
+		if !state.PoolID.IsNull() && string(lease.PoolID) != state.PoolID.ValueString() {
+			continue
+		}
+		if !state.ServerID.IsNull() && string(lease.ServerID) != state.ServerID.ValueString() {
+			continue
+		}
+		if !state.MAC.IsNull() && normalizeMAC(lease.MAC) != filters["mac"] {
+			continue
+		}
+		if !state.State.IsNull() && lease.State != state.State.ValueString() {
+			continue
+		}
+
+		state.Leases = append(state.Leases, dhcpleasesModel{
+			ID:        types.StringValue(string(lease.ID)),
+			PoolID:    types.StringValue(string(lease.PoolID)),
+			ServerID:  types.StringValue(string(lease.ServerID)),
+			IPAddress: types.StringValue(lease.IPAddress),
+			MAC:       types.StringValue(normalizeMAC(lease.MAC)),
+			Hostname:  types.StringValue(lease.Hostname),
+			State:     types.StringValue(lease.State),
+			Starts:    types.StringValue(lease.Starts),
+			Ends:      types.StringValue(lease.Ends),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
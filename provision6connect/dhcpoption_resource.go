@@ -0,0 +1,345 @@
+package provision6connect
+
+import (
+	"context"
+	"strconv"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dhcpoptionResource{}
+	_ resource.ResourceWithConfigure      = &dhcpoptionResource{}
+	_ resource.ResourceWithImportState    = &dhcpoptionResource{}
+	_ resource.ResourceWithValidateConfig = &dhcpoptionResource{}
+)
+
+// dhcpOptionResourceType is the ProVision resource type used to store DHCP options
+// as children of the DHCP server, group, pool or host they apply to.
+const dhcpOptionResourceType = "dhcpoption"
+
+// NewDHCPoptionResource is a helper function to simplify the provider implementation.
+func NewDHCPoptionResource() resource.Resource {
+	return &dhcpoptionResource{}
+}
+
+// dhcpoptionModel maps DHCP option schema data.
+type dhcpoptionModel struct {
+	ID       types.String `tfsdk:"id"`
+	ScopeID  types.String `tfsdk:"scope_id"`
+	Code     types.Int64  `tfsdk:"code"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+	Modified types.String `tfsdk:"modified"`
+}
+
+// dhcpoptionToResource builds the ProVision resource holding the option.
+func dhcpoptionToResource(plan dhcpoptionModel, definition dhcpOptionDefinition) provisionclient.Resource {
+	attrs := map[string]string{
+		"option_name":  definition.Name,
+		"option_type":  definition.Type,
+		"option_value": plan.Value.ValueString(),
+	}
+	if definition.Code != 0 {
+		attrs["option_code"] = strconv.FormatInt(definition.Code, 10)
+	}
+
+	return provisionclient.Resource{
+		ID:       provisionclient.PVID(plan.ID.ValueString()),
+		ParentID: provisionclient.PVID(plan.ScopeID.ValueString()),
+		Name:     definition.Name,
+		Type:     dhcpOptionResourceType,
+		Attrs:    attrs,
+	}
+}
+
+// dhcpoptionFromResource maps the ProVision resource back into the model.
+func dhcpoptionFromResource(state *dhcpoptionModel, pvresource provisionclient.Resource) {
+	state.ID = types.StringValue(string(pvresource.ID))
+	state.ScopeID = types.StringValue(string(pvresource.ParentID))
+	state.Name = types.StringValue(pvresource.Attrs["option_name"])
+	state.Type = types.StringValue(pvresource.Attrs["option_type"])
+	state.Value = types.StringValue(pvresource.Attrs["option_value"])
+	state.Modified = types.StringValue(pvresource.Modified)
+
+	state.Code = types.Int64Null()
+	if code, err := strconv.ParseInt(pvresource.Attrs["option_code"], 10, 64); err == nil {
+		state.Code = types.Int64Value(code)
+	}
+}
+
+// dhcpoptionResource is the resource implementation.
+type dhcpoptionResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dhcpoptionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *dhcpoptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_option"
+}
+
+// Schema defines the schema for the resource.
+func (r *dhcpoptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DHCP Option set on a ProVision DHCP server, group, pool or host. Either code or name must be set, type is required for custom option codes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the DHCP Option.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scope_id": schema.StringAttribute{
+				Description: "Resource ID of the DHCP server, group, pool or host the option applies to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code": schema.Int64Attribute{
+				Description: "DHCP Option code between 1 and 254 Ex: 3",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "DHCP Option name Ex: routers, domain-name-servers, next-server, filename",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "DHCP Option value type, it can be ip, ip_list, string, integer, boolean or hex",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringOneOf(dhcpOptionTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "DHCP Option value, ip_list values are comma separated and hex values use the 0a:0b:0c format",
+				Required:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "Date and Time of the last modification",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the option code, name and value against the known option table.
+func (r *dhcpoptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dhcpoptionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Code.IsUnknown() || config.Name.IsUnknown() || config.Type.IsUnknown() {
+		return
+	}
+
+	definition, err := resolveDHCPOption(config.Code.ValueInt64(), config.Name.ValueString(), config.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid DHCP Option",
+			"The DHCP Option could not be resolved: "+err.Error(),
+		)
+		return
+	}
+
+	if config.Value.IsUnknown() {
+		return
+	}
+
+	if err := validateDHCPOptionValue(definition, config.Value.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid DHCP Option Value",
+			"The value for DHCP Option "+definition.Name+" is not valid: "+err.Error(),
+		)
+	}
+}
+
+// Create a new resource
+func (r *dhcpoptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dhcpoptionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	definition, err := resolveDHCPOption(plan.Code.ValueInt64(), plan.Name.ValueString(), plan.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid DHCP Option",
+			"The DHCP Option could not be resolved: "+err.Error(),
+		)
+		return
+	}
+
+	// Create new option
+	pvresource, err := r.client.Resources.AddResource(dhcpoptionToResource(plan, definition))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new ProVision DHCP Option",
+			"Could not create ProVision DHCP Option, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(string(pvresource.ID))
+	plan.Name = types.StringValue(definition.Name)
+	plan.Type = types.StringValue(definition.Type)
+	plan.Modified = types.StringValue(pvresource.Modified)
+	plan.Code = types.Int64Null()
+	if definition.Code != 0 {
+		plan.Code = types.Int64Value(definition.Code)
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dhcpoptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Read resource information
+func (r *dhcpoptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dhcpoptionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources, err := r.client.Resources.GetResources(&map[string]string{
+		"id":              state.ID.ValueString(),
+		"load_attributes": "1",
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DHCP Option",
+			"Could not read ProVision DHCP Option ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(resources) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision DHCP Option",
+			"ProVision DHCP Option has not been found ID "+state.ID.ValueString(),
+		)
+		return
+	}
+
+	dhcpoptionFromResource(&state, resources[0])
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dhcpoptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dhcpoptionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating DHCP Option ID "+plan.ID.ValueString())
+
+	definition, err := resolveDHCPOption(plan.Code.ValueInt64(), plan.Name.ValueString(), plan.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid DHCP Option",
+			"The DHCP Option could not be resolved: "+err.Error(),
+		)
+		return
+	}
+
+	// Update existing option
+	pvresource, err := r.client.Resources.UpdateResource(dhcpoptionToResource(plan, definition))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating ProVision DHCP Option",
+			"Could not update ProVision DHCP Option, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Modified = types.StringValue(pvresource.Modified)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dhcpoptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dhcpoptionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing option
+	err := r.client.Resources.DeleteResourceByID(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision DHCP Option",
+			"Could not delete ProVision DHCP Option, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,213 @@
+package provision6connect
+
+import (
+	"context"
+	"math"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dhcppoolutilizationDataSourceModel maps the data source schema data.
+type dhcppoolutilizationDataSourceModel struct {
+	PoolID   types.String               `tfsdk:"pool_id"`
+	ServerID types.String               `tfsdk:"server_id"`
+	Pools    []dhcppoolutilizationModel `tfsdk:"pools"`
+}
+
+// dhcppoolutilizationModel maps DHCP pool utilization schema data.
+type dhcppoolutilizationModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Name           types.String  `tfsdk:"name"`
+	ServerID       types.String  `tfsdk:"server_id"`
+	RangeStart     types.String  `tfsdk:"range_start"`
+	RangeEnd       types.String  `tfsdk:"range_end"`
+	TotalAddresses types.Int64   `tfsdk:"total_addresses"`
+	ActiveLeases   types.Int64   `tfsdk:"active_leases"`
+	FreeAddresses  types.Int64   `tfsdk:"free_addresses"`
+	PercentUsed    types.Float64 `tfsdk:"percent_used"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dhcppoolutilizationDataSource{}
+	_ datasource.DataSourceWithConfigure = &dhcppoolutilizationDataSource{}
+)
+
+// NewDHCPpoolutilizationDataSource is a helper function to simplify the provider implementation.
+func NewDHCPpoolutilizationDataSource() datasource.DataSource {
+	return &dhcppoolutilizationDataSource{}
+}
+
+// dhcppoolutilizationDataSource is the data source implementation.
+type dhcppoolutilizationDataSource struct {
+	client *provisionclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dhcppoolutilizationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dhcp_pool_utilization"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dhcppoolutilizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *dhcppoolutilizationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DHCP Pool Utilization Data Source for query the active leases and free addresses of ProVision DHCP pools",
+		Attributes: map[string]schema.Attribute{
+			"pool_id": schema.StringAttribute{
+				Description: "Pool Resource ID to report the utilization for",
+				Optional:    true,
+			},
+			"server_id": schema.StringAttribute{
+				Description: "Server Resource ID to report the utilization of all its pools",
+				Optional:    true,
+			},
+			"pools": schema.ListNestedAttribute{
+				Description: "Contains a list of the DHCP Pools found by the search query with their utilization",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the Pool",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Pool Name",
+							Computed:    true,
+						},
+						"server_id": schema.StringAttribute{
+							Description: "Server Resource ID serving the pool",
+							Computed:    true,
+						},
+						"range_start": schema.StringAttribute{
+							Description: "First IP Address of the pool range",
+							Computed:    true,
+						},
+						"range_end": schema.StringAttribute{
+							Description: "Last IP Address of the pool range",
+							Computed:    true,
+						},
+						"total_addresses": schema.Int64Attribute{
+							Description: "Number of addresses in the pool range",
+							Computed:    true,
+						},
+						"active_leases": schema.Int64Attribute{
+							Description: "Number of active leases in the pool",
+							Computed:    true,
+						},
+						"free_addresses": schema.Int64Attribute{
+							Description: "Number of addresses without an active lease",
+							Computed:    true,
+						},
+						"percent_used": schema.Float64Attribute{
+							Description: "Percentage of the pool addresses with an active lease",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dhcppoolutilizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dhcppoolutilizationDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := map[string]string{}
+	if !state.PoolID.IsNull() {
+		filters["id"] = state.PoolID.ValueString()
+	} else if !state.ServerID.IsNull() {
+		filters["server_id"] = state.ServerID.ValueString()
+	} else {
+		resp.Diagnostics.AddError(
+			"Either pool_id or server_id are required",
+			"Either pool_id or server_id are required",
+		)
+		return
+	}
+
+	pools, err := getDHCPPools(&d.client.DHCP, &filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision DHCP Pools",
+			err.Error(),
+		)
+		return
+	}
+
+	for _, pool := range pools {
+		if !state.PoolID.IsNull() && string(pool.ID) != state.PoolID.ValueString() {
+			continue
+		}
+		if !state.ServerID.IsNull() && string(pool.ServerID) != state.ServerID.ValueString() {
+			continue
+		}
+
+		leases, err := getDHCPLeases(&d.client.DHCP, &map[string]string{
+			"pool_id": string(pool.ID),
+			"state":   "active",
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read ProVision DHCP Leases",
+				"Could not read the leases of DHCP Pool ID "+string(pool.ID)+": "+err.Error(),
+			)
+			return
+		}
+
+		var active int64
+		for _, lease := range leases {
+			if string(lease.PoolID) == string(pool.ID) && lease.State == "active" {
+				active++
+			}
+		}
+
+		total := dhcpPoolSize(pool.RangeStart, pool.RangeEnd)
+		free := total - active
+		if free < 0 {
+			free = 0
+		}
+
+		var percent float64
+		if total > 0 {
+			percent = math.Round(float64(active)/float64(total)*10000) / 100
+		}
+
+		state.Pools = append(state.Pools, dhcppoolutilizationModel{
+			ID:             types.StringValue(string(pool.ID)),
+			Name:           types.StringValue(pool.Name),
+			ServerID:       types.StringValue(string(pool.ServerID)),
+			RangeStart:     types.StringValue(pool.RangeStart),
+			RangeEnd:       types.StringValue(pool.RangeEnd),
+			TotalAddresses: types.Int64Value(total),
+			ActiveLeases:   types.Int64Value(active),
+			FreeAddresses:  types.Int64Value(free),
+			PercentUsed:    types.Float64Value(percent),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
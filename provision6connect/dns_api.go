@@ -0,0 +1,199 @@
+package provision6connect
+
+import (
+	"fmt"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// dnsServer is a DNS server as returned by the ProVision DNS servers API.
+type dnsServer struct {
+	ID             provisionclient.PVID `json:"id,omitempty"`
+	ParentID       provisionclient.PVID `json:"parent_id,omitempty"`
+	Name           string               `json:"name"`
+	ServerType     string               `json:"server_type,omitempty"`
+	Host           string               `json:"host,omitempty"`
+	Port           int                  `json:"port,string,omitempty"`
+	ConnectionType string               `json:"connection_type,omitempty"`
+	Username       string               `json:"username,omitempty"`
+	Password       string               `json:"password,omitempty"`
+	APIKey         string               `json:"api_key,omitempty"`
+	ConfigPath     string               `json:"config_path,omitempty"`
+	Status         string               `json:"status,omitempty"`
+	Modified       string               `json:"modified,omitempty"`
+}
+
+// dnsGroup is a DNS server group as returned by the ProVision DNS groups API.
+type dnsGroup struct {
+	ID        provisionclient.PVID    `json:"id,omitempty"`
+	ParentID  provisionclient.PVID    `json:"parent_id,omitempty"`
+	Name      string                  `json:"name"`
+	ServerIDs *[]provisionclient.PVID `json:"server_ids,omitempty"`
+	ZoneIDs   *[]provisionclient.PVID `json:"zone_ids,omitempty"`
+	Modified  string                  `json:"modified,omitempty"`
+}
+
+func getDNSServerByID(dns *provisionclient.DNSMethods, id string) ([]dnsServer, error) {
+	servers := []dnsServer{}
+	err := apiRequest(dns.Client, "GET", "/dns/servers", &map[string]string{"id": id}, nil, &servers)
+	if err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+func addDNSServer(dns *provisionclient.DNSMethods, server dnsServer) (*dnsServer, error) {
+	var resp_server dnsServer
+	err := apiRequest(dns.Client, "POST", "/dns/servers", nil, server, &resp_server)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_server, nil
+}
+
+func updateDNSServer(dns *provisionclient.DNSMethods, server dnsServer) (*dnsServer, error) {
+	var resp_server dnsServer
+	err := apiRequest(dns.Client, "PATCH", "/dns/servers/"+string(server.ID), nil, server, &resp_server)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_server, nil
+}
+
+func deleteDNSServerByID(dns *provisionclient.DNSMethods, id string) error {
+	return apiRequest(dns.Client, "DELETE", "/dns/servers/"+id, nil, nil, nil)
+}
+
+func getDNSGroupByID(dns *provisionclient.DNSMethods, id string) ([]dnsGroup, error) {
+	groups := []dnsGroup{}
+	err := apiRequest(dns.Client, "GET", "/dns/groups", &map[string]string{"id": id}, nil, &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func addDNSGroup(dns *provisionclient.DNSMethods, group dnsGroup) (*dnsGroup, error) {
+	var resp_group dnsGroup
+	err := apiRequest(dns.Client, "POST", "/dns/groups", nil, group, &resp_group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_group, nil
+}
+
+func updateDNSGroup(dns *provisionclient.DNSMethods, group dnsGroup) (*dnsGroup, error) {
+	var resp_group dnsGroup
+	err := apiRequest(dns.Client, "PATCH", "/dns/groups/"+string(group.ID), nil, group, &resp_group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_group, nil
+}
+
+func deleteDNSGroupByID(dns *provisionclient.DNSMethods, id string) error {
+	return apiRequest(dns.Client, "DELETE", "/dns/groups/"+id, nil, nil, nil)
+}
+
+// canonicalFQDN lower cases a DNS name and makes sure it ends with a dot.
+func canonicalFQDN(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// findDNSZonesByName returns the zones whose name matches, optionally limited to a group.
+func findDNSZonesByName(dns *provisionclient.DNSMethods, name, groupID string) ([]provisionclient.DNSZone, error) {
+	filters := map[string]string{
+		"name": canonicalFQDN(name),
+	}
+	if groupID != "" {
+		filters["group_id"] = groupID
+	}
+
+	zones, err := dns.GetZones(&filters)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []provisionclient.DNSZone{}
+	for _, zone := range zones {
+		if canonicalFQDN(zone.Name) != canonicalFQDN(name) {
+			continue
+		}
+		if groupID != "" && string(zone.GroupID) != groupID {
+			continue
+		}
+		matches = append(matches, zone)
+	}
+
+	return matches, nil
+}
+
+// findDNSZoneForHost returns the zone with the longest name containing the host.
+func findDNSZoneForHost(dns *provisionclient.DNSMethods, host string) (*provisionclient.DNSZone, error) {
+	zones, err := dns.GetZones(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []provisionclient.DNSZone
+	for _, zone := range zones {
+		if !dnsNameInZone(host, zone.Name) {
+			continue
+		}
+		if len(matches) != 0 && len(canonicalFQDN(zone.Name)) < len(canonicalFQDN(matches[0].Name)) {
+			continue
+		}
+		if len(matches) != 0 && len(canonicalFQDN(zone.Name)) > len(canonicalFQDN(matches[0].Name)) {
+			matches = nil
+		}
+		matches = append(matches, zone)
+	}
+
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("no DNS Zone containing %s has been found", host)
+	}
+
+	if len(matches) > 1 {
+		return nil, fmt.Errorf("the DNS Zone %s exists in several groups (%s), set zone_id to select one of them", matches[0].Name, dnsZoneGroups(matches))
+	}
+
+	return &matches[0], nil
+}
+
+// findDNSZoneByName returns the single zone with the given name.
+func findDNSZoneByName(dns *provisionclient.DNSMethods, name string) (*provisionclient.DNSZone, error) {
+	zones, err := findDNSZonesByName(dns, name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("the DNS Zone %s has not been found", canonicalFQDN(name))
+	}
+
+	if len(zones) > 1 {
+		return nil, fmt.Errorf("the DNS Zone %s exists in several groups (%s), set zone_id to select one of them", canonicalFQDN(name), dnsZoneGroups(zones))
+	}
+
+	return &zones[0], nil
+}
+
+// dnsZoneGroups lists the groups of the zones for diagnostics.
+func dnsZoneGroups(zones []provisionclient.DNSZone) string {
+	groups := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		groups = append(groups, string(zone.GroupID))
+	}
+	return strings.Join(groups, ", ")
+}
@@ -0,0 +1,242 @@
+package provision6connect
+
+import (
+	"fmt"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// sameDNSRecord reports whether two records have the same type, host and value.
+func sameDNSRecord(a, b provisionclient.DNSRecord) bool {
+	return strings.EqualFold(a.RecordType, b.RecordType) &&
+		canonicalFQDN(a.RecordHost) == canonicalFQDN(b.RecordHost) &&
+		dnsRecordValuesEqual(a.RecordType, a.RecordValue, b.RecordValue)
+}
+
+// dnsRecordKey identifies a record by its type and host.
+func dnsRecordKey(record provisionclient.DNSRecord) string {
+	return strings.ToUpper(record.RecordType) + " " + canonicalFQDN(record.RecordHost)
+}
+
+// desiredDNSRecord returns the record with its type and value normalized for ProVision,
+// the host is used as name when it has none.
+func desiredDNSRecord(record provisionclient.DNSRecord) provisionclient.DNSRecord {
+	if record.Name == "" {
+		record.Name = record.RecordHost
+	}
+	if knownDNSRecordType(record.RecordType) {
+		if value, err := normalizeDNSRecordValue(record.RecordType, record.RecordValue); err == nil {
+			record.RecordValue = value
+		}
+		record.RecordType = strings.ToUpper(record.RecordType)
+	}
+	return record
+}
+
+// syncDNSRecords converges the records currently in a zone to the desired ones with
+// the fewest changes. Records that already match are kept, records with the same
+// type and host are updated in place, the rest are added or deleted. Desired records
+// without a name keep the name of the record they replace. The records returned
+// match the order of desired.
+func syncDNSRecords(dns *provisionclient.DNSMethods, current, desired []provisionclient.DNSRecord) ([]provisionclient.DNSRecord, error) {
+	result := make([]provisionclient.DNSRecord, len(desired))
+	matched := make([]bool, len(current))
+	done := make([]bool, len(desired))
+
+	// Records that are already there, only the TTL or the name may change
+	for i, record := range desired {
+		for j, existing := range current {
+			if matched[j] || !sameDNSRecord(existing, record) {
+				continue
+			}
+			matched[j] = true
+			done[i] = true
+			result[i] = existing
+
+			if existing.RecordTTL != record.RecordTTL || (record.Name != "" && existing.Name != record.Name) {
+				update := desiredDNSRecord(record)
+				update.ID = existing.ID
+				update.ParentID = existing.ParentID
+				if record.Name == "" {
+					update.Name = existing.Name
+				}
+				updated, err := dns.UpdateZoneRecord(update)
+				if err != nil {
+					return nil, fmt.Errorf("could not update %s record %s: %w", update.RecordType, update.RecordHost, err)
+				}
+				result[i] = *updated
+			}
+			break
+		}
+	}
+
+	// Records replacing another one with the same type and host
+	for i, record := range desired {
+		if done[i] {
+			continue
+		}
+
+		for j, existing := range current {
+			if matched[j] || dnsRecordKey(existing) != dnsRecordKey(record) {
+				continue
+			}
+			matched[j] = true
+			done[i] = true
+
+			update := desiredDNSRecord(record)
+			update.ID = existing.ID
+			update.ParentID = existing.ParentID
+			if record.Name == "" {
+				update.Name = existing.Name
+			}
+			updated, err := dns.UpdateZoneRecord(update)
+			if err != nil {
+				return nil, fmt.Errorf("could not update %s record %s: %w", update.RecordType, update.RecordHost, err)
+			}
+			result[i] = *updated
+			break
+		}
+	}
+
+	// New records
+	for i, record := range desired {
+		if done[i] {
+			continue
+		}
+
+		added, err := dns.AddZoneRecord(desiredDNSRecord(record))
+		if err != nil {
+			return nil, fmt.Errorf("could not add %s record %s: %w", record.RecordType, record.RecordHost, err)
+		}
+		result[i] = *added
+	}
+
+	// Records no longer wanted, the SOA is never deleted as the zone can not go without it
+	for j, existing := range current {
+		if matched[j] || strings.EqualFold(existing.RecordType, "SOA") {
+			continue
+		}
+
+		err := dns.DeleteZoneRecordByID(string(existing.ParentID), string(existing.ID))
+		if err != nil {
+			return nil, fmt.Errorf("could not delete %s record %s: %w", existing.RecordType, existing.RecordHost, err)
+		}
+	}
+
+	return result, nil
+}
+
+// findDNSRecords returns the records of a zone with the given host and type.
+func findDNSRecords(dns *provisionclient.DNSMethods, zoneID, host, recordType string) ([]provisionclient.DNSRecord, error) {
+	records, err := dns.GetZoneRecords(zoneID, &map[string]string{
+		"record_host": host,
+		"record_type": strings.ToUpper(recordType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []provisionclient.DNSRecord{}
+	for _, record := range records {
+		if strings.EqualFold(record.RecordType, recordType) && canonicalFQDN(record.RecordHost) == canonicalFQDN(host) {
+			if record.ParentID == "" {
+				record.ParentID = provisionclient.PVID(zoneID)
+			}
+			matches = append(matches, record)
+		}
+	}
+	return matches, nil
+}
+
+// checkRecordHostInZone makes sure the record host is inside the zone, the zone
+// not being readable is only reported as a warning.
+func checkRecordHostInZone(dns *provisionclient.DNSMethods, zoneID, host string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zones, err := dns.GetZoneByID(zoneID)
+	if err != nil {
+		diags.AddWarning(
+			"Unable to Read ProVision DNS Zone",
+			"The DNS Record host could not be checked against DNS Zone ID "+zoneID+": "+err.Error(),
+		)
+		return diags
+	}
+
+	if len(zones) == 0 {
+		diags.AddAttributeError(
+			path.Root("zone_id"),
+			"Error Finding ProVision DNS Zone",
+			"ProVision DNS Zone has not been found ID "+zoneID,
+		)
+		return diags
+	}
+
+	if !dnsNameInZone(host, zones[0].Name) {
+		diags.AddAttributeError(
+			path.Root("record_host"),
+			"DNS Record Outside of DNS Zone",
+			"record_host "+host+" is not inside the DNS Zone "+zones[0].Name+".",
+		)
+	}
+	return diags
+}
+
+// zoneManagedRecords returns the zone and its records, the SOA and the NS records of
+// the zone apex are left out when ignoreApex is set.
+func zoneManagedRecords(dns *provisionclient.DNSMethods, zoneID string, ignoreApex bool) (*provisionclient.DNSZone, []provisionclient.DNSRecord, error) {
+	zones, err := dns.GetZoneByID(zoneID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(zones) == 0 {
+		return nil, nil, fmt.Errorf("DNS Zone ID %s has not been found", zoneID)
+	}
+
+	records, err := dns.GetZoneRecords(zoneID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	managed := make([]provisionclient.DNSRecord, 0, len(records))
+	for _, record := range records {
+		if ignoreApex && isApexDNSRecord(record, zones[0].Name) {
+			continue
+		}
+		if record.ParentID == "" {
+			record.ParentID = provisionclient.PVID(zoneID)
+		}
+		managed = append(managed, record)
+	}
+	return &zones[0], managed, nil
+}
+
+// isApexDNSRecord reports whether the record is the SOA or an NS record of the zone apex.
+func isApexDNSRecord(record provisionclient.DNSRecord, zoneName string) bool {
+	return strings.EqualFold(record.RecordType, "SOA") ||
+		strings.EqualFold(record.RecordType, "NS") && canonicalFQDN(record.RecordHost) == canonicalFQDN(zoneName)
+}
+
+// deleteDeclaredDNSRecords deletes the current records matching one of the declared
+// ones, except the SOA.
+func deleteDeclaredDNSRecords(dns *provisionclient.DNSMethods, zoneID string, current, declared []provisionclient.DNSRecord) error {
+	for _, record := range current {
+		if strings.EqualFold(record.RecordType, "SOA") {
+			continue
+		}
+		for _, existing := range declared {
+			if !sameDNSRecord(record, existing) {
+				continue
+			}
+
+			err := dns.DeleteZoneRecordByID(zoneID, string(record.ID))
+			if err != nil {
+				return fmt.Errorf("could not delete ProVision DNS Record ID %s: %w", record.ID, err)
+			}
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,317 @@
+package provision6connect
+
+import (
+	"context"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsgroupResource{}
+	_ resource.ResourceWithConfigure   = &dnsgroupResource{}
+	_ resource.ResourceWithImportState = &dnsgroupResource{}
+)
+
+// NewDNSgroupResource is a helper function to simplify the provider implementation.
+func NewDNSgroupResource() resource.Resource {
+	return &dnsgroupResource{}
+}
+
+// dnsgroupModel maps DNS group schema data.
+type dnsgroupModel struct {
+	ID        types.String `tfsdk:"id"`
+	ParentID  types.String `tfsdk:"parent_id"`
+	Name      types.String `tfsdk:"name"`
+	ServerIDs types.Set    `tfsdk:"server_ids"`
+	ZoneIDs   types.Set    `tfsdk:"zone_ids"`
+	Modified  types.String `tfsdk:"modified"`
+}
+
+// pvidSetValue converts a list of ProVision IDs into a Set of strings.
+func pvidSetValue(ctx context.Context, ids []provisionclient.PVID) (types.Set, diag.Diagnostics) {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, string(id))
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// pvidSetElements converts a Set of strings into a list of ProVision IDs.
+func pvidSetElements(ctx context.Context, set types.Set) ([]provisionclient.PVID, diag.Diagnostics) {
+	ids := []provisionclient.PVID{}
+	if set.IsNull() || set.IsUnknown() {
+		return ids, nil
+	}
+
+	var values []string
+	diags := set.ElementsAs(ctx, &values, false)
+	for _, value := range values {
+		ids = append(ids, provisionclient.PVID(value))
+	}
+	return ids, diags
+}
+
+func (r *dnsgroupResource) planToGroup(ctx context.Context, plan dnsgroupModel) (dnsGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	group := dnsGroup{
+		ID:   provisionclient.PVID(plan.ID.ValueString()),
+		Name: plan.Name.ValueString(),
+	}
+	if !plan.ParentID.IsUnknown() {
+		group.ParentID = provisionclient.PVID(plan.ParentID.ValueString())
+	}
+
+	// Memberships are only sent when they are managed from the group.
+	if !plan.ServerIDs.IsUnknown() {
+		serverIDs, d := pvidSetElements(ctx, plan.ServerIDs)
+		diags.Append(d...)
+		group.ServerIDs = &serverIDs
+	}
+	if !plan.ZoneIDs.IsUnknown() {
+		zoneIDs, d := pvidSetElements(ctx, plan.ZoneIDs)
+		diags.Append(d...)
+		group.ZoneIDs = &zoneIDs
+	}
+
+	return group, diags
+}
+
+func dnsgroupToState(ctx context.Context, state *dnsgroupModel, group *dnsGroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	state.ID = types.StringValue(string(group.ID))
+	state.ParentID = types.StringValue(string(group.ParentID))
+	state.Name = types.StringValue(group.Name)
+	state.Modified = types.StringValue(group.Modified)
+
+	var serverIDs, zoneIDs []provisionclient.PVID
+	if group.ServerIDs != nil {
+		serverIDs = *group.ServerIDs
+	}
+	if group.ZoneIDs != nil {
+		zoneIDs = *group.ZoneIDs
+	}
+
+	var d diag.Diagnostics
+	state.ServerIDs, d = pvidSetValue(ctx, serverIDs)
+	diags.Append(d...)
+	state.ZoneIDs, d = pvidSetValue(ctx, zoneIDs)
+	diags.Append(d...)
+
+	return diags
+}
+
+// dnsgroupResource is the resource implementation.
+type dnsgroupResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsgroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsgroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsgroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS Group Resource that groups DNS servers and the zones pushed to them",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Group.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Parent Resource ID for the DNS Group, if it is not set ProVision will set TLR by default.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "DNS Group Name",
+				Required:    true,
+			},
+			"server_ids": schema.SetAttribute{
+				Description: "DNS Server IDs that are members of the group",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"zone_ids": schema.SetAttribute{
+				Description: "DNS Zone IDs assigned to the group. If it is not set the membership is left to the group_id of each provision6connect_dnszone.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "Date and Time of the last modification",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource
+func (r *dnsgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsgroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newGroup, diags := r.planToGroup(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new group
+	group, err := addDNSGroup(&r.client.DNS, newGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new ProVision DNS Group",
+			"Could not create ProVision DNS Group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(dnsgroupToState(ctx, &plan, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnsgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Read resource information
+func (r *dnsgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsgroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := getDNSGroupByID(&r.client.DNS, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Group",
+			"Could not read ProVision DNS Group ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(groups) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision DNS Group",
+			"ProVision DNS Group has not been found ID "+state.ID.ValueString(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(dnsgroupToState(ctx, &state, &groups[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnsgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsgroupModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating DNS Group ID "+plan.ID.ValueString())
+
+	newGroup, diags := r.planToGroup(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing group
+	group, err := updateDNSGroup(&r.client.DNS, newGroup)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating ProVision DNS Group",
+			"Could not update ProVision DNS Group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(dnsgroupToState(ctx, &plan, group)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsgroupModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing group
+	err := deleteDNSGroupByID(&r.client.DNS, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision DNS Group",
+			"Could not delete ProVision DNS Group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -2,8 +2,12 @@ package provision6connect
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
+	"strings"
 
 	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,9 +19,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &dnsrecordResource{}
-	_ resource.ResourceWithConfigure   = &dnsrecordResource{}
-	_ resource.ResourceWithImportState = &dnsrecordResource{}
+	_ resource.Resource                   = &dnsrecordResource{}
+	_ resource.ResourceWithConfigure      = &dnsrecordResource{}
+	_ resource.ResourceWithImportState    = &dnsrecordResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsrecordResource{}
+	_ resource.ResourceWithValidateConfig = &dnsrecordResource{}
 )
 
 // NewDNSrecordResource is a helper function to simplify the provider implementation.
@@ -29,6 +35,7 @@ func NewDNSrecordResource() resource.Resource {
 type dnsrecordModel struct {
 	ID       types.String `tfsdk:"id"`
 	ZoneID   types.String `tfsdk:"zone_id"`
+	ZoneName types.String `tfsdk:"zone_name"`
 	Name     types.String `tfsdk:"name"`
 	Modified types.String `tfsdk:"modified"`
 
@@ -37,6 +44,14 @@ type dnsrecordModel struct {
 	RecordHost  types.String `tfsdk:"record_host"`
 	RecordValue types.String `tfsdk:"record_value"`
 	RecordTTL   types.Int64  `tfsdk:"record_ttl"`
+
+	MX  types.Object `tfsdk:"mx"`
+	SRV types.Object `tfsdk:"srv"`
+	CAA types.Object `tfsdk:"caa"`
+
+	CreatePTR   types.Bool   `tfsdk:"create_ptr"`
+	PTRZoneID   types.String `tfsdk:"ptr_zone_id"`
+	PTRRecordID types.String `tfsdk:"ptr_record_id"`
 }
 
 // dnsrecordResource is the resource implementation.
@@ -75,11 +90,13 @@ func (r *dnsrecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 			},
 			"zone_id": schema.StringAttribute{
-				Description: "Numeric identifier of the DNS Zone that contains the DNS Record.",
-				Required:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Description: "Numeric identifier of the DNS Zone that contains the DNS Record. When neither zone_id nor zone_name is set, the zone with the longest name containing record_host is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"zone_name": schema.StringAttribute{
+				Description: "Name of the DNS Zone that contains the DNS Record in FQDN format Ex: example.com., it is resolved to zone_id while planning and can not be set together with zone_id.",
+				Optional:    true,
 			},
 			"modified": schema.StringAttribute{
 				Description: "Date and Time of the last modification",
@@ -90,23 +107,403 @@ func (r *dnsrecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 			"record_type": schema.StringAttribute{
-				Description: "DNS Record Type Ex: A, AAAA, TXT, PTR, NS",
+				Description: "DNS Record Type Ex: A, AAAA, TXT, PTR, NS. The values of A, AAAA, CNAME, MX, TXT, SRV, PTR, NS, CAA and SSHFP records are validated and normalized before they are sent to ProVision.",
 				Required:    true,
 			},
 			"record_host": schema.StringAttribute{
-				Description: "FQDN of the DNS Record Ex: test.example.com.",
+				Description: "FQDN of the DNS Record ending with a dot and inside the DNS Zone Ex: test.example.com.",
 				Required:    true,
 			},
 			"record_value": schema.StringAttribute{
-				Description: "DNS Record Value Ex: 192.168.0.1",
-				Required:    true,
+				Description: "DNS Record Value Ex: 192.168.0.1. Target names must be FQDN ending with a dot, TXT values are quoted and split into strings of at most 255 bytes. It is set from mx, srv or caa when one of them is used instead.",
+				Optional:    true,
+				Computed:    true,
 			},
 			"record_ttl": schema.Int64Attribute{
 				Description: "DNS Record TTL Ex: 900",
 				Required:    true,
 			},
+			"create_ptr": schema.BoolAttribute{
+				Description: "Manage the matching PTR record of an A or AAAA record in the reverse DNS Zone covering record_value",
+				Optional:    true,
+			},
+			"ptr_zone_id": schema.StringAttribute{
+				Description: "Numeric identifier of the reverse DNS Zone of the PTR record, it is looked up from record_value if it is not set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ptr_record_id": schema.StringAttribute{
+				Description: "Numeric identifier of the PTR record managed by create_ptr",
+				Computed:    true,
+			},
 		},
 	}
+
+	for name, attribute := range dnsrecordStructuredAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// ValidateConfig validates the record host and value for the record type and
+// makes sure create_ptr is only set on address records.
+func (r *dnsrecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dnsrecordModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RecordHost.IsUnknown() {
+		if err := validateDNSName(config.RecordHost.ValueString(), false, true); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("record_host"),
+				"Invalid DNS Record Host",
+				"record_host "+err.Error(),
+			)
+		} else if !strings.HasSuffix(config.RecordHost.ValueString(), ".") {
+			// Hosts without the trailing dot have always been sent as they are
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("record_host"),
+				"DNS Record Host Is Not a FQDN",
+				"record_host "+config.RecordHost.ValueString()+" should be a FQDN ending with a dot, for Example: "+config.RecordHost.ValueString()+".",
+			)
+		}
+	}
+
+	if !config.ZoneID.IsNull() && !config.ZoneName.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_name"),
+			"Conflicting DNS Zone Configuration",
+			"zone_name can not be set together with zone_id.",
+		)
+	}
+
+	if !config.ZoneName.IsNull() && !config.ZoneName.IsUnknown() {
+		if err := validateDNSName(config.ZoneName.ValueString(), true, false); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("zone_name"),
+				"Invalid DNS Zone Name",
+				"zone_name "+err.Error(),
+			)
+		}
+	}
+
+	if !config.RecordTTL.IsUnknown() && (config.RecordTTL.ValueInt64() < 0 || config.RecordTTL.ValueInt64() > 2147483647) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("record_ttl"),
+			"Invalid DNS Record TTL",
+			"record_ttl must be between 0 and 2147483647.",
+		)
+	}
+
+	structuredType, structuredPath, structuredCount := structuredRecordType(config)
+	switch {
+	case structuredCount > 1:
+		resp.Diagnostics.AddAttributeError(
+			structuredPath,
+			"Conflicting DNS Record Value Configuration",
+			"Only one of mx, srv or caa can be set.",
+		)
+		return
+	case structuredCount == 1 && !config.RecordValue.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("record_value"),
+			"Conflicting DNS Record Value Configuration",
+			"record_value can not be set together with mx, srv or caa.",
+		)
+		return
+	case structuredCount == 0 && config.RecordValue.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("record_value"),
+			"Missing DNS Record Value",
+			"Either record_value, mx, srv or caa must be set.",
+		)
+		return
+	}
+
+	if structuredCount == 1 {
+		if !config.RecordType.IsUnknown() && !strings.EqualFold(config.RecordType.ValueString(), structuredType) {
+			resp.Diagnostics.AddAttributeError(
+				structuredPath,
+				"Invalid DNS Record Value Configuration",
+				structuredPath.String()+" can only be set on "+structuredType+" records.",
+			)
+			return
+		}
+
+		value, known, diags := structuredRecordValue(ctx, config)
+		resp.Diagnostics.Append(diags...)
+		if known {
+			if _, err := normalizeDNSRecordValue(structuredType, value); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					structuredPath,
+					"Invalid DNS Record Value",
+					err.Error(),
+				)
+			}
+		}
+	} else if !config.RecordType.IsUnknown() {
+		if !knownDNSRecordType(config.RecordType.ValueString()) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("record_type"),
+				"Unvalidated DNS Record Type",
+				"The value of "+config.RecordType.ValueString()+" records is not validated by the provider, the supported types are "+strings.Join(dnsRecordTypes, ", ")+".",
+			)
+		} else if !config.RecordValue.IsUnknown() {
+			if _, err := normalizeDNSRecordValue(config.RecordType.ValueString(), config.RecordValue.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("record_value"),
+					"Invalid DNS Record Value",
+					err.Error(),
+				)
+			}
+		}
+	}
+
+	if !config.CreatePTR.ValueBool() {
+		return
+	}
+
+	if !config.RecordType.IsUnknown() && !strings.EqualFold(config.RecordType.ValueString(), "A") && !strings.EqualFold(config.RecordType.ValueString(), "AAAA") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("create_ptr"),
+			"Invalid PTR Record Configuration",
+			"create_ptr can only be set on A and AAAA records.",
+		)
+		return
+	}
+
+	if !config.RecordValue.IsUnknown() {
+		if _, err := netip.ParseAddr(config.RecordValue.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("record_value"),
+				"Invalid PTR Record Configuration",
+				"create_ptr requires record_value to be an IP address: "+err.Error(),
+			)
+		}
+	}
+}
+
+// ModifyPlan sets record_value from the structured value, resolves zone_id from
+// zone_name or record_host and makes sure the record host is inside the zone of the record.
+func (r *dnsrecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dnsrecordModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state dnsrecordModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.RecordValue.IsUnknown() {
+		value, known, diags := structuredRecordValue(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if known {
+			// Keep the value in state when it only differs in its format
+			if !req.State.Raw.IsNull() && dnsRecordValuesEqual(plan.RecordType.ValueString(), state.RecordValue.ValueString(), value) {
+				value = state.RecordValue.ValueString()
+			}
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("record_value"), types.StringValue(value))...)
+		}
+	}
+
+	sameHost := !req.State.Raw.IsNull() && canonicalFQDN(state.RecordHost.ValueString()) == canonicalFQDN(plan.RecordHost.ValueString())
+
+	// The zone is looked up again only when zone_name or the host changed
+	if plan.ZoneID.IsUnknown() && sameHost && plan.ZoneName.Equal(state.ZoneName) && !state.ZoneID.IsNull() {
+		plan.ZoneID = state.ZoneID
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("zone_id"), plan.ZoneID)...)
+	}
+
+	if r.client == nil || plan.RecordHost.IsUnknown() {
+		return
+	}
+
+	if plan.ZoneID.IsUnknown() {
+		if plan.ZoneName.IsUnknown() {
+			return
+		}
+
+		zone, diags := r.resolveZone(plan)
+		resp.Diagnostics.Append(diags...)
+		if zone == nil {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("zone_id"), types.StringValue(string(zone.ID)))...)
+
+		if !dnsNameInZone(plan.RecordHost.ValueString(), zone.Name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("record_host"),
+				"DNS Record Outside of DNS Zone",
+				"record_host "+plan.RecordHost.ValueString()+" is not inside the DNS Zone "+zone.Name+".",
+			)
+		}
+		return
+	}
+
+	// The zone is only read when the host or the zone changed
+	if sameHost && state.ZoneID.Equal(plan.ZoneID) {
+		return
+	}
+
+	resp.Diagnostics.Append(checkRecordHostInZone(&r.client.DNS, plan.ZoneID.ValueString(), plan.RecordHost.ValueString())...)
+}
+
+// resolveZone returns the zone named by zone_name, or the zone with the longest name
+// containing record_host when zone_name is not set.
+func (r *dnsrecordResource) resolveZone(plan dnsrecordModel) (*provisionclient.DNSZone, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !plan.ZoneName.IsNull() {
+		zone, err := findDNSZoneByName(&r.client.DNS, plan.ZoneName.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("zone_name"),
+				"Error Finding ProVision DNS Zone",
+				"Could not resolve zone_name "+plan.ZoneName.ValueString()+": "+err.Error(),
+			)
+			return nil, diags
+		}
+		return zone, diags
+	}
+
+	zone, err := findDNSZoneForHost(&r.client.DNS, plan.RecordHost.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("record_host"),
+			"Error Finding ProVision DNS Zone",
+			"Could not find the DNS Zone of record_host "+plan.RecordHost.ValueString()+", set zone_id or zone_name: "+err.Error(),
+		)
+		return nil, diags
+	}
+	return zone, diags
+}
+
+// recordValue returns the record type and value in the format sent to ProVision.
+func recordValue(plan dnsrecordModel) (string, string) {
+	recordType := plan.RecordType.ValueString()
+	if !knownDNSRecordType(recordType) {
+		return recordType, plan.RecordValue.ValueString()
+	}
+
+	value, err := normalizeDNSRecordValue(recordType, plan.RecordValue.ValueString())
+	if err != nil {
+		return strings.ToUpper(recordType), plan.RecordValue.ValueString()
+	}
+	return strings.ToUpper(recordType), value
+}
+
+// recordToState sets the record values read from ProVision, values that only differ
+// from the current ones in their format are kept.
+func recordToState(state *dnsrecordModel, dnsrecord provisionclient.DNSRecord) {
+	if !strings.EqualFold(state.RecordType.ValueString(), dnsrecord.RecordType) {
+		state.RecordType = types.StringValue(dnsrecord.RecordType)
+	}
+	if canonicalFQDN(state.RecordHost.ValueString()) != canonicalFQDN(dnsrecord.RecordHost) {
+		state.RecordHost = types.StringValue(dnsrecord.RecordHost)
+	}
+	if !dnsRecordValuesEqual(dnsrecord.RecordType, state.RecordValue.ValueString(), dnsrecord.RecordValue) {
+		state.RecordValue = types.StringValue(dnsrecord.RecordValue)
+	}
+	state.RecordTTL = types.Int64Value(int64(dnsrecord.RecordTTL))
+}
+
+// ptrZone returns the reverse zone of the PTR record and the PTR record host inside it.
+func (r *dnsrecordResource) ptrZone(plan dnsrecordModel) (*provisionclient.DNSZone, string, error) {
+	ip, err := netip.ParseAddr(plan.RecordValue.ValueString())
+	if err != nil {
+		return nil, "", err
+	}
+
+	if plan.PTRZoneID.IsNull() || plan.PTRZoneID.IsUnknown() {
+		return findReverseZone(&r.client.DNS, ip)
+	}
+
+	zones, err := r.client.DNS.GetZoneByID(plan.PTRZoneID.ValueString())
+	if err != nil {
+		return nil, "", err
+	}
+	if len(zones) == 0 {
+		return nil, "", fmt.Errorf("reverse DNS Zone ID %s has not been found", plan.PTRZoneID.ValueString())
+	}
+
+	host, ok := reverseZoneHost(zones[0].Name, ip)
+	if !ok {
+		return nil, "", fmt.Errorf("reverse DNS Zone %s does not cover %s", zones[0].Name, ip)
+	}
+	return &zones[0], host, nil
+}
+
+// updatePTR converges the PTR record from the one in state to the one in the reverse
+// zone, a nil zone removes it. The PTR attributes of the plan are updated to the result.
+func (r *dnsrecordResource) updatePTR(plan *dnsrecordModel, state dnsrecordModel, zone *provisionclient.DNSZone, host string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	current := state.PTRRecordID.ValueString()
+	if current != "" && (zone == nil || string(zone.ID) != state.PTRZoneID.ValueString()) {
+		err := r.client.DNS.DeleteZoneRecordByID(state.PTRZoneID.ValueString(), current)
+		if err != nil {
+			plan.PTRZoneID = state.PTRZoneID
+			plan.PTRRecordID = state.PTRRecordID
+			diags.AddError(
+				"Error Deleting ProVision PTR Record",
+				"Could not delete ProVision PTR Record, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+		current = ""
+	}
+
+	plan.PTRRecordID = types.StringNull()
+	if current != "" {
+		plan.PTRRecordID = types.StringValue(current)
+	}
+
+	if zone == nil {
+		if plan.PTRZoneID.IsUnknown() {
+			plan.PTRZoneID = types.StringNull()
+		}
+		return diags
+	}
+	plan.PTRZoneID = types.StringValue(string(zone.ID))
+
+	record := provisionclient.DNSRecord{
+		ID:          provisionclient.PVID(current),
+		Name:        plan.Name.ValueString(),
+		ParentID:    zone.ID,
+		RecordType:  "PTR",
+		RecordHost:  host,
+		RecordValue: canonicalFQDN(plan.RecordHost.ValueString()),
+		RecordTTL:   int(plan.RecordTTL.ValueInt64()),
+	}
+
+	var ptr *provisionclient.DNSRecord
+	var err error
+	if current != "" {
+		ptr, err = r.client.DNS.UpdateZoneRecord(record)
+	} else {
+		ptr, err = r.client.DNS.AddZoneRecord(record)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Updating ProVision PTR Record",
+			"Could not set the PTR record "+host+" in reverse DNS Zone "+zone.Name+", unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.PTRRecordID = types.StringValue(string(ptr.ID))
+	return diags
 }
 
 // Create a new resource
@@ -119,11 +516,42 @@ func (r *dnsrecordResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if plan.ZoneID.IsUnknown() {
+		zone, diags := r.resolveZone(plan)
+		resp.Diagnostics.Append(diags...)
+		if zone == nil {
+			return
+		}
+		plan.ZoneID = types.StringValue(string(zone.ID))
+	}
+
+	// The reverse zone is looked up first so a missing one does not leave a forward record behind
+	var ptrZone *provisionclient.DNSZone
+	var ptrHost string
+	if plan.CreatePTR.ValueBool() {
+		var err error
+		ptrZone, ptrHost, err = r.ptrZone(plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Finding ProVision Reverse DNS Zone",
+				"Could not find the reverse DNS Zone for "+plan.RecordValue.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.RecordValue.IsUnknown() {
+		value, _, diags := structuredRecordValue(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		plan.RecordValue = types.StringValue(value)
+	}
+
+	recordType, value := recordValue(plan)
 	newRecord := provisionclient.DNSRecord{
 		Name:        plan.Name.ValueString(),
 		ParentID:    provisionclient.PVID(plan.ZoneID.ValueString()),
-		RecordType:  plan.RecordType.ValueString(),
-		RecordValue: plan.RecordValue.ValueString(),
+		RecordType:  recordType,
+		RecordValue: value,
 		RecordHost:  plan.RecordHost.ValueString(),
 		RecordTTL:   int(plan.RecordTTL.ValueInt64()),
 	}
@@ -144,6 +572,16 @@ func (r *dnsrecordResource) Create(ctx context.Context, req resource.CreateReque
 	plan.Modified = types.StringValue(dnsrecord.Modified)
 	plan.Status = types.StringValue(dnsrecord.Status)
 
+	ptrDiags := r.updatePTR(&plan, dnsrecordModel{}, ptrZone, ptrHost)
+	if ptrDiags.HasError() {
+		err := r.client.DNS.DeleteZoneRecordByID(plan.ZoneID.ValueString(), plan.ID.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, "Could not remove DNS Record ID "+plan.ID.ValueString()+": "+err.Error())
+		}
+		resp.Diagnostics.Append(ptrDiags...)
+		return
+	}
+
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -195,10 +633,29 @@ func (r *dnsrecordResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.ZoneID = types.StringValue(string(dnsrecord.ParentID))
 	state.Modified = types.StringValue(dnsrecord.Modified)
 	state.Status = types.StringValue(dnsrecord.Status)
-	state.RecordHost = types.StringValue(dnsrecord.RecordHost)
-	state.RecordValue = types.StringValue(dnsrecord.RecordValue)
-	state.RecordType = types.StringValue(dnsrecord.RecordType)
-	state.RecordTTL = types.Int64Value(int64(dnsrecord.RecordTTL))
+	recordToState(&state, dnsrecord)
+	resp.Diagnostics.Append(structuredRecordToState(ctx, &state, dnsrecord.RecordValue)...)
+
+	if !state.PTRRecordID.IsNull() {
+		ptrs, err := r.client.DNS.GetZoneRecords(state.PTRZoneID.ValueString(), &map[string]string{
+			"id": state.PTRRecordID.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading ProVision PTR Record",
+				"Could not read ProVision PTR Record ID "+state.PTRRecordID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		// A missing or changed PTR record shows up as create_ptr drift so the next apply fixes it
+		if len(ptrs) == 0 {
+			state.PTRRecordID = types.StringNull()
+			state.CreatePTR = types.BoolValue(false)
+		} else if canonicalFQDN(ptrs[0].RecordValue) != canonicalFQDN(dnsrecord.RecordHost) {
+			state.CreatePTR = types.BoolValue(false)
+		}
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -217,12 +674,50 @@ func (r *dnsrecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 	tflog.Info(ctx, "Updating DNS Record ID "+plan.ID.ValueString())
+
+	var state dnsrecordModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.ZoneID.IsUnknown() {
+		zone, diags := r.resolveZone(plan)
+		resp.Diagnostics.Append(diags...)
+		if zone == nil {
+			return
+		}
+		plan.ZoneID = types.StringValue(string(zone.ID))
+	}
+
+	var ptrZone *provisionclient.DNSZone
+	var ptrHost string
+	if plan.CreatePTR.ValueBool() {
+		var err error
+		ptrZone, ptrHost, err = r.ptrZone(plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Finding ProVision Reverse DNS Zone",
+				"Could not find the reverse DNS Zone for "+plan.RecordValue.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.RecordValue.IsUnknown() {
+		value, _, diags := structuredRecordValue(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		plan.RecordValue = types.StringValue(value)
+	}
+
+	recordType, value := recordValue(plan)
 	newRecord := provisionclient.DNSRecord{
 		ID:          provisionclient.PVID(plan.ID.ValueString()),
 		Name:        plan.Name.ValueString(),
 		ParentID:    provisionclient.PVID(plan.ZoneID.ValueString()),
-		RecordType:  plan.RecordType.ValueString(),
-		RecordValue: plan.RecordValue.ValueString(),
+		RecordType:  recordType,
+		RecordValue: value,
 		RecordHost:  plan.RecordHost.ValueString(),
 		RecordTTL:   int(plan.RecordTTL.ValueInt64()),
 	}
@@ -242,10 +737,11 @@ func (r *dnsrecordResource) Update(ctx context.Context, req resource.UpdateReque
 	plan.ZoneID = types.StringValue(string(dnsrecord.ParentID))
 	plan.Modified = types.StringValue(dnsrecord.Modified)
 	plan.Status = types.StringValue(dnsrecord.Status)
-	plan.RecordHost = types.StringValue(dnsrecord.RecordHost)
-	plan.RecordValue = types.StringValue(dnsrecord.RecordValue)
-	plan.RecordType = types.StringValue(dnsrecord.RecordType)
-	plan.RecordTTL = types.Int64Value(int64(dnsrecord.RecordTTL))
+	recordToState(&plan, *dnsrecord)
+	resp.Diagnostics.Append(structuredRecordToState(ctx, &plan, dnsrecord.RecordValue)...)
+
+	// The state is saved even if the PTR record fails so the forward record change is kept
+	resp.Diagnostics.Append(r.updatePTR(&plan, state, ptrZone, ptrHost)...)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -264,6 +760,17 @@ func (r *dnsrecordResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if !state.PTRRecordID.IsNull() {
+		err := r.client.DNS.DeleteZoneRecordByID(state.PTRZoneID.ValueString(), state.PTRRecordID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting ProVision PTR Record",
+				"Could not delete ProVision PTR Record, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	// Delete existing order
 	err := r.client.DNS.DeleteZoneRecordByID(state.ZoneID.ValueString(), state.ID.ValueString())
 	if err != nil {
@@ -0,0 +1,240 @@
+package provision6connect
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// dnsrecordMXModel maps the structured value of an MX record.
+type dnsrecordMXModel struct {
+	Preference types.Int64  `tfsdk:"preference"`
+	Exchange   types.String `tfsdk:"exchange"`
+}
+
+// dnsrecordSRVModel maps the structured value of an SRV record.
+type dnsrecordSRVModel struct {
+	Priority types.Int64  `tfsdk:"priority"`
+	Weight   types.Int64  `tfsdk:"weight"`
+	Port     types.Int64  `tfsdk:"port"`
+	Target   types.String `tfsdk:"target"`
+}
+
+// dnsrecordCAAModel maps the structured value of a CAA record.
+type dnsrecordCAAModel struct {
+	Flags types.Int64  `tfsdk:"flags"`
+	Tag   types.String `tfsdk:"tag"`
+	Value types.String `tfsdk:"value"`
+}
+
+var dnsrecordMXAttrTypes = map[string]attr.Type{
+	"preference": types.Int64Type,
+	"exchange":   types.StringType,
+}
+
+var dnsrecordSRVAttrTypes = map[string]attr.Type{
+	"priority": types.Int64Type,
+	"weight":   types.Int64Type,
+	"port":     types.Int64Type,
+	"target":   types.StringType,
+}
+
+var dnsrecordCAAAttrTypes = map[string]attr.Type{
+	"flags": types.Int64Type,
+	"tag":   types.StringType,
+	"value": types.StringType,
+}
+
+// dnsrecordStructuredAttributes returns the schema of the structured record values.
+func dnsrecordStructuredAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"mx": schema.SingleNestedAttribute{
+			Description: "Structured value of an MX record, record_value is set from it",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"preference": schema.Int64Attribute{
+					Description: "MX preference Ex: 10",
+					Required:    true,
+				},
+				"exchange": schema.StringAttribute{
+					Description: "Mail server in FQDN format Ex: mail.example.com.",
+					Required:    true,
+				},
+			},
+		},
+		"srv": schema.SingleNestedAttribute{
+			Description: "Structured value of an SRV record, record_value is set from it",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"priority": schema.Int64Attribute{
+					Description: "SRV priority Ex: 10",
+					Required:    true,
+				},
+				"weight": schema.Int64Attribute{
+					Description: "SRV weight Ex: 5",
+					Required:    true,
+				},
+				"port": schema.Int64Attribute{
+					Description: "SRV port Ex: 5060",
+					Required:    true,
+				},
+				"target": schema.StringAttribute{
+					Description: "Target host in FQDN format Ex: sip.example.com.",
+					Required:    true,
+				},
+			},
+		},
+		"caa": schema.SingleNestedAttribute{
+			Description: "Structured value of a CAA record, record_value is set from it",
+			Optional:    true,
+			Attributes: map[string]schema.Attribute{
+				"flags": schema.Int64Attribute{
+					Description: "CAA flags, 128 marks the property as critical Ex: 0",
+					Required:    true,
+				},
+				"tag": schema.StringAttribute{
+					Description: "CAA property tag Ex: issue, issuewild, iodef",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "CAA property value without quotes Ex: letsencrypt.org",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// structuredRecordType returns the record type and path of the structured value set
+// in the model, an empty type means none of them is set.
+func structuredRecordType(model dnsrecordModel) (string, path.Path, int) {
+	var recordType string
+	var attributePath path.Path
+	count := 0
+
+	for _, structured := range []struct {
+		recordType string
+		name       string
+		value      types.Object
+	}{
+		{"MX", "mx", model.MX},
+		{"SRV", "srv", model.SRV},
+		{"CAA", "caa", model.CAA},
+	} {
+		if structured.value.IsNull() {
+			continue
+		}
+		recordType = structured.recordType
+		attributePath = path.Root(structured.name)
+		count++
+	}
+
+	return recordType, attributePath, count
+}
+
+// structuredRecordValue renders the structured value set in the model into a record
+// value, it reports false when none is set or some of its values are not known yet.
+func structuredRecordValue(ctx context.Context, model dnsrecordModel) (string, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !model.MX.IsNull():
+		if model.MX.IsUnknown() {
+			return "", false, diags
+		}
+		var mx dnsrecordMXModel
+		diags.Append(model.MX.As(ctx, &mx, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() || mx.Preference.IsUnknown() || mx.Exchange.IsUnknown() {
+			return "", false, diags
+		}
+		return formatMXValue(mx.Preference.ValueInt64(), mx.Exchange.ValueString()), true, diags
+
+	case !model.SRV.IsNull():
+		if model.SRV.IsUnknown() {
+			return "", false, diags
+		}
+		var srv dnsrecordSRVModel
+		diags.Append(model.SRV.As(ctx, &srv, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() || srv.Priority.IsUnknown() || srv.Weight.IsUnknown() || srv.Port.IsUnknown() || srv.Target.IsUnknown() {
+			return "", false, diags
+		}
+		return formatSRVValue(srv.Priority.ValueInt64(), srv.Weight.ValueInt64(), srv.Port.ValueInt64(), srv.Target.ValueString()), true, diags
+
+	case !model.CAA.IsNull():
+		if model.CAA.IsUnknown() {
+			return "", false, diags
+		}
+		var caa dnsrecordCAAModel
+		diags.Append(model.CAA.As(ctx, &caa, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() || caa.Flags.IsUnknown() || caa.Tag.IsUnknown() || caa.Value.IsUnknown() {
+			return "", false, diags
+		}
+		return formatCAAValue(caa.Flags.ValueInt64(), caa.Tag.ValueString(), caa.Value.ValueString()), true, diags
+	}
+
+	return "", false, diags
+}
+
+// structuredRecordToState parses the record value read from ProVision into the
+// structured value set in the state, values that only differ in their format are kept.
+func structuredRecordToState(ctx context.Context, state *dnsrecordModel, value string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case !state.MX.IsNull() && !state.MX.IsUnknown():
+		preference, exchange, err := parseMXValue(value)
+		if err != nil {
+			return diags
+		}
+		var mx dnsrecordMXModel
+		diags.Append(state.MX.As(ctx, &mx, basetypes.ObjectAsOptions{})...)
+		mx.Preference = types.Int64Value(preference)
+		if canonicalFQDN(mx.Exchange.ValueString()) != canonicalFQDN(exchange) {
+			mx.Exchange = types.StringValue(exchange)
+		}
+		var d diag.Diagnostics
+		state.MX, d = types.ObjectValueFrom(ctx, dnsrecordMXAttrTypes, mx)
+		diags.Append(d...)
+
+	case !state.SRV.IsNull() && !state.SRV.IsUnknown():
+		priority, weight, port, target, err := parseSRVValue(value)
+		if err != nil {
+			return diags
+		}
+		var srv dnsrecordSRVModel
+		diags.Append(state.SRV.As(ctx, &srv, basetypes.ObjectAsOptions{})...)
+		srv.Priority = types.Int64Value(priority)
+		srv.Weight = types.Int64Value(weight)
+		srv.Port = types.Int64Value(port)
+		if canonicalFQDN(srv.Target.ValueString()) != canonicalFQDN(target) {
+			srv.Target = types.StringValue(target)
+		}
+		var d diag.Diagnostics
+		state.SRV, d = types.ObjectValueFrom(ctx, dnsrecordSRVAttrTypes, srv)
+		diags.Append(d...)
+
+	case !state.CAA.IsNull() && !state.CAA.IsUnknown():
+		flags, tag, caaValue, err := parseCAAValue(value)
+		if err != nil {
+			return diags
+		}
+		var caa dnsrecordCAAModel
+		diags.Append(state.CAA.As(ctx, &caa, basetypes.ObjectAsOptions{})...)
+		caa.Flags = types.Int64Value(flags)
+		if !strings.EqualFold(caa.Tag.ValueString(), tag) {
+			caa.Tag = types.StringValue(tag)
+		}
+		caa.Value = types.StringValue(caaValue)
+		var d diag.Diagnostics
+		state.CAA, d = types.ObjectValueFrom(ctx, dnsrecordCAAAttrTypes, caa)
+		diags.Append(d...)
+	}
+
+	return diags
+}
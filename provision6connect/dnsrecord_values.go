@@ -0,0 +1,352 @@
+package provision6connect
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxTXTStringLength is the longest character-string allowed in a TXT record.
+const maxTXTStringLength = 255
+
+// dnsRecordTypes lists the record types validated and normalized by the provider.
+var dnsRecordTypes = []string{"A", "AAAA", "CNAME", "MX", "TXT", "SRV", "PTR", "NS", "CAA", "SSHFP"}
+
+// knownDNSRecordType reports whether the record type is validated by the provider.
+func knownDNSRecordType(recordType string) bool {
+	for _, known := range dnsRecordTypes {
+		if strings.EqualFold(recordType, known) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateDNSName checks a domain name, the trailing dot is required when fqdn is set.
+// A wildcard is only accepted as the first label when wildcard is set.
+func validateDNSName(name string, fqdn, wildcard bool) error {
+	if name == "." {
+		return nil
+	}
+	if fqdn && !strings.HasSuffix(name, ".") {
+		return fmt.Errorf("%q must be a FQDN ending with a dot, for Example: %s.", name, name)
+	}
+
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" || len(trimmed) > 253 {
+		return fmt.Errorf("%q must be between 1 and 253 characters long", name)
+	}
+
+	for i, label := range strings.Split(trimmed, ".") {
+		if label == "" || len(label) > 63 {
+			return fmt.Errorf("%q labels must be between 1 and 63 characters long", name)
+		}
+		if label == "*" {
+			if !wildcard || i != 0 {
+				return fmt.Errorf("%q can only contain a wildcard as its first label", name)
+			}
+			continue
+		}
+		for _, c := range label {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '/') {
+				return fmt.Errorf("%q contains the invalid character %q", name, c)
+			}
+		}
+	}
+	return nil
+}
+
+// splitRecordFields splits a record value into at most n whitespace separated
+// fields, the last one holding the rest of the value.
+func splitRecordFields(value string, n int) []string {
+	fields := []string{}
+	rest := strings.TrimSpace(value)
+	for len(fields) < n-1 && rest != "" {
+		end := strings.IndexAny(rest, " \t")
+		if end < 0 {
+			break
+		}
+		fields = append(fields, rest[:end])
+		rest = strings.TrimSpace(rest[end:])
+	}
+	if rest != "" {
+		fields = append(fields, rest)
+	}
+	return fields
+}
+
+// parseRecordUint parses a numeric record field limited to max.
+func parseRecordUint(field, name string, max uint64) (int64, error) {
+	value, err := strconv.ParseUint(field, 10, 64)
+	if err != nil || value > max {
+		return 0, fmt.Errorf("%s %q must be a number between 0 and %d", name, field, max)
+	}
+	return int64(value), nil
+}
+
+// parseMXValue parses an MX record value in the "preference exchange" format.
+func parseMXValue(value string) (int64, string, error) {
+	fields := splitRecordFields(value, 2)
+	if len(fields) != 2 || strings.ContainsAny(fields[1], " \t") {
+		return 0, "", fmt.Errorf("MX record value %q must be in the format \"preference exchange\", for Example: 10 mail.example.com.", value)
+	}
+
+	preference, err := parseRecordUint(fields[0], "MX preference", 65535)
+	if err != nil {
+		return 0, "", err
+	}
+	if err := validateDNSName(fields[1], true, false); err != nil {
+		return 0, "", fmt.Errorf("MX exchange %s", err)
+	}
+	return preference, strings.ToLower(fields[1]), nil
+}
+
+// formatMXValue renders an MX record value.
+func formatMXValue(preference int64, exchange string) string {
+	return strconv.FormatInt(preference, 10) + " " + exchange
+}
+
+// parseSRVValue parses an SRV record value in the "priority weight port target" format.
+func parseSRVValue(value string) (int64, int64, int64, string, error) {
+	fields := splitRecordFields(value, 4)
+	if len(fields) != 4 || strings.ContainsAny(fields[3], " \t") {
+		return 0, 0, 0, "", fmt.Errorf("SRV record value %q must be in the format \"priority weight port target\", for Example: 10 5 5060 sip.example.com.", value)
+	}
+
+	var numbers [3]int64
+	for i, name := range []string{"SRV priority", "SRV weight", "SRV port"} {
+		number, err := parseRecordUint(fields[i], name, 65535)
+		if err != nil {
+			return 0, 0, 0, "", err
+		}
+		numbers[i] = number
+	}
+	if err := validateDNSName(fields[3], true, false); err != nil {
+		return 0, 0, 0, "", fmt.Errorf("SRV target %s", err)
+	}
+	return numbers[0], numbers[1], numbers[2], strings.ToLower(fields[3]), nil
+}
+
+// formatSRVValue renders an SRV record value.
+func formatSRVValue(priority, weight, port int64, target string) string {
+	return strconv.FormatInt(priority, 10) + " " + strconv.FormatInt(weight, 10) + " " + strconv.FormatInt(port, 10) + " " + target
+}
+
+// parseCAAValue parses a CAA record value in the "flags tag value" format, the
+// value may be quoted.
+func parseCAAValue(value string) (int64, string, string, error) {
+	fields := splitRecordFields(value, 3)
+	if len(fields) != 3 {
+		return 0, "", "", fmt.Errorf("CAA record value %q must be in the format \"flags tag value\", for Example: 0 issue \"letsencrypt.org\"", value)
+	}
+
+	flags, err := parseRecordUint(fields[0], "CAA flags", 255)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	tag := strings.ToLower(fields[1])
+	if len(tag) > 15 {
+		return 0, "", "", fmt.Errorf("CAA tag %q must be at most 15 characters long", fields[1])
+	}
+	for _, c := range tag {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9') {
+			return 0, "", "", fmt.Errorf("CAA tag %q must only contain letters and digits", fields[1])
+		}
+	}
+
+	caaValue := fields[2]
+	if len(caaValue) >= 2 && strings.HasPrefix(caaValue, `"`) && strings.HasSuffix(caaValue, `"`) {
+		caaValue = caaValue[1 : len(caaValue)-1]
+	}
+	if strings.Contains(caaValue, `"`) {
+		return 0, "", "", fmt.Errorf("CAA value %q must not contain quotes", caaValue)
+	}
+	return flags, tag, caaValue, nil
+}
+
+// formatCAAValue renders a CAA record value with the value quoted.
+func formatCAAValue(flags int64, tag, value string) string {
+	return strconv.FormatInt(flags, 10) + " " + tag + ` "` + value + `"`
+}
+
+// parseSSHFPValue parses an SSHFP record value in the "algorithm type fingerprint" format.
+func parseSSHFPValue(value string) (string, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 3 {
+		return "", fmt.Errorf("SSHFP record value %q must be in the format \"algorithm type fingerprint\", for Example: 4 2 123456789abcdef67890123456789abcdef67890123456789abcdef123456789", value)
+	}
+
+	algorithm, err := parseRecordUint(fields[0], "SSHFP algorithm", 255)
+	if err != nil {
+		return "", err
+	}
+	switch algorithm {
+	case 1, 2, 3, 4, 6:
+	default:
+		return "", fmt.Errorf("SSHFP algorithm %d must be one of 1 (RSA), 2 (DSA), 3 (ECDSA), 4 (Ed25519) or 6 (Ed448)", algorithm)
+	}
+
+	fpType, err := parseRecordUint(fields[1], "SSHFP type", 255)
+	if err != nil {
+		return "", err
+	}
+	fingerprint := strings.ToLower(fields[2])
+	if _, err := hex.DecodeString(fingerprint); err != nil {
+		return "", fmt.Errorf("SSHFP fingerprint %q must be hexadecimal", fields[2])
+	}
+	switch {
+	case fpType == 1 && len(fingerprint) != 40:
+		return "", fmt.Errorf("SSHFP SHA-1 fingerprint must be 40 hexadecimal characters long")
+	case fpType == 2 && len(fingerprint) != 64:
+		return "", fmt.Errorf("SSHFP SHA-256 fingerprint must be 64 hexadecimal characters long")
+	case fpType != 1 && fpType != 2:
+		return "", fmt.Errorf("SSHFP type %d must be 1 (SHA-1) or 2 (SHA-256)", fpType)
+	}
+
+	return strconv.FormatInt(algorithm, 10) + " " + strconv.FormatInt(fpType, 10) + " " + fingerprint, nil
+}
+
+// parseTXTValue returns the character-strings of a TXT record value. Quoted
+// values are split into their strings, unquoted values are taken as a single
+// string. Strings longer than 255 bytes are split into several on character
+// boundaries.
+func parseTXTValue(value string) ([]string, error) {
+	trimmed := strings.TrimSpace(value)
+
+	var parts []string
+	if strings.HasPrefix(trimmed, `"`) {
+		var current strings.Builder
+		quoted := false
+		for i := 0; i < len(trimmed); i++ {
+			c := trimmed[i]
+			switch {
+			case quoted && c == '\\' && i+1 < len(trimmed):
+				i++
+				current.WriteByte(trimmed[i])
+			case c == '"':
+				if quoted {
+					parts = append(parts, current.String())
+					current.Reset()
+				}
+				quoted = !quoted
+			case quoted:
+				current.WriteByte(c)
+			case c != ' ' && c != '\t':
+				return nil, fmt.Errorf("TXT record value %q has text outside of the quoted strings", value)
+			}
+		}
+		if quoted {
+			return nil, fmt.Errorf("TXT record value %q has an unterminated quoted string", value)
+		}
+	} else {
+		parts = []string{value}
+	}
+
+	chunks := []string{}
+	for _, part := range parts {
+		for len(part) > maxTXTStringLength {
+			// Multi-byte characters are kept whole in one of the strings
+			cut := maxTXTStringLength
+			for cut > 0 && !utf8.RuneStart(part[cut]) {
+				cut--
+			}
+			chunks = append(chunks, part[:cut])
+			part = part[cut:]
+		}
+		chunks = append(chunks, part)
+	}
+	return chunks, nil
+}
+
+// formatTXTValue renders TXT character-strings quoted and separated by spaces.
+func formatTXTValue(chunks []string) string {
+	quoted := make([]string, 0, len(chunks))
+	for _, chunk := range chunks {
+		chunk = strings.ReplaceAll(chunk, `\`, `\\`)
+		chunk = strings.ReplaceAll(chunk, `"`, `\"`)
+		quoted = append(quoted, `"`+chunk+`"`)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// normalizeDNSRecordValue validates a record value for its type and returns it
+// in the format sent to ProVision. Values of types not validated by the provider
+// are returned as they are.
+func normalizeDNSRecordValue(recordType, value string) (string, error) {
+	switch strings.ToUpper(recordType) {
+	case "A":
+		ip, err := netip.ParseAddr(strings.TrimSpace(value))
+		if err != nil || !ip.Is4() {
+			return "", fmt.Errorf("A record value %q must be an IPv4 address", value)
+		}
+		return ip.String(), nil
+	case "AAAA":
+		ip, err := netip.ParseAddr(strings.TrimSpace(value))
+		if err != nil || !ip.Is6() || ip.Zone() != "" {
+			return "", fmt.Errorf("AAAA record value %q must be an IPv6 address", value)
+		}
+		return ip.String(), nil
+	case "CNAME", "NS", "PTR":
+		target := strings.TrimSpace(value)
+		if err := validateDNSName(target, true, false); err != nil {
+			return "", fmt.Errorf("%s record value %s", strings.ToUpper(recordType), err)
+		}
+		return strings.ToLower(target), nil
+	case "MX":
+		preference, exchange, err := parseMXValue(value)
+		if err != nil {
+			return "", err
+		}
+		return formatMXValue(preference, exchange), nil
+	case "SRV":
+		priority, weight, port, target, err := parseSRVValue(value)
+		if err != nil {
+			return "", err
+		}
+		return formatSRVValue(priority, weight, port, target), nil
+	case "CAA":
+		flags, tag, caaValue, err := parseCAAValue(value)
+		if err != nil {
+			return "", err
+		}
+		return formatCAAValue(flags, tag, caaValue), nil
+	case "SSHFP":
+		return parseSSHFPValue(value)
+	case "TXT":
+		chunks, err := parseTXTValue(value)
+		if err != nil {
+			return "", err
+		}
+		return formatTXTValue(chunks), nil
+	}
+	return value, nil
+}
+
+// dnsRecordValuesEqual reports whether two record values are the same once
+// normalized, TXT values are compared by their content.
+func dnsRecordValuesEqual(recordType, a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	if strings.EqualFold(recordType, "TXT") {
+		chunksA, errA := parseTXTValue(a)
+		chunksB, errB := parseTXTValue(b)
+		return errA == nil && errB == nil && strings.Join(chunksA, "") == strings.Join(chunksB, "")
+	}
+
+	normalizedA, errA := normalizeDNSRecordValue(recordType, a)
+	normalizedB, errB := normalizeDNSRecordValue(recordType, b)
+	return errA == nil && errB == nil && normalizedA == normalizedB
+}
+
+// dnsNameInZone reports whether the name is the zone apex or a name below it.
+func dnsNameInZone(name, zone string) bool {
+	name = canonicalFQDN(name)
+	zone = canonicalFQDN(zone)
+	return name == zone || strings.HasSuffix(name, "."+zone)
+}
@@ -0,0 +1,214 @@
+package provision6connect
+
+import (
+	"context"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnsrecordsDataSourceModel maps the data source schema data.
+type dnsrecordsDataSourceModel struct {
+	ZoneID      types.String      `tfsdk:"zone_id"`
+	ZoneName    types.String      `tfsdk:"zone_name"`
+	RecordType  types.String      `tfsdk:"record_type"`
+	RecordHost  types.String      `tfsdk:"record_host"`
+	RecordValue types.String      `tfsdk:"record_value"`
+	Records     []dnsrecordsModel `tfsdk:"records"`
+}
+
+// dnsrecordsModel maps DNS record schema data.
+type dnsrecordsModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Modified    types.String `tfsdk:"modified"`
+	Status      types.String `tfsdk:"status"`
+	RecordType  types.String `tfsdk:"record_type"`
+	RecordHost  types.String `tfsdk:"record_host"`
+	RecordValue types.String `tfsdk:"record_value"`
+	RecordTTL   types.Int64  `tfsdk:"record_ttl"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsrecordsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsrecordsDataSource{}
+)
+
+// NewDNSrecordsDataSource is a helper function to simplify the provider implementation.
+func NewDNSrecordsDataSource() datasource.DataSource {
+	return &dnsrecordsDataSource{}
+}
+
+// dnsrecordsDataSource is the data source implementation.
+type dnsrecordsDataSource struct {
+	client *provisionclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnsrecordsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnsrecords"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dnsrecordsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *dnsrecordsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS Records Data Source for listing the records of a ProVision DNS Zone, optionally filtered by type, host and value",
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone that contains the DNS Records",
+				Required:    true,
+			},
+			"zone_name": schema.StringAttribute{
+				Description: "DNS Zone Name of the zone_id",
+				Computed:    true,
+			},
+			"record_type": schema.StringAttribute{
+				Description: "Only return DNS Records of this type Ex: A, AAAA, TXT",
+				Optional:    true,
+			},
+			"record_host": schema.StringAttribute{
+				Description: "Only return DNS Records with this FQDN Ex: test.example.com.",
+				Optional:    true,
+			},
+			"record_value": schema.StringAttribute{
+				Description: "Only return DNS Records with this value Ex: 192.168.0.1",
+				Optional:    true,
+			},
+			"records": schema.ListNestedAttribute{
+				Description: "Contains a list of the DNS Records found by the search query",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the DNS Record",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Pretty name describing the DNS Record",
+							Computed:    true,
+						},
+						"modified": schema.StringAttribute{
+							Description: "Date and Time of the last modification",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Current status set by ProVision of the DNS Record",
+							Computed:    true,
+						},
+						"record_type": schema.StringAttribute{
+							Description: "DNS Record Type",
+							Computed:    true,
+						},
+						"record_host": schema.StringAttribute{
+							Description: "FQDN of the DNS Record",
+							Computed:    true,
+						},
+						"record_value": schema.StringAttribute{
+							Description: "DNS Record Value",
+							Computed:    true,
+						},
+						"record_ttl": schema.Int64Attribute{
+							Description: "DNS Record TTL",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnsrecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsrecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zones, err := d.client.DNS.GetZoneByID(state.ZoneID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision DNS Zone",
+			"Could not read ProVision DNS Zone ID "+state.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(zones) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision DNS Zone",
+			"ProVision DNS Zone has not been found ID "+state.ZoneID.ValueString(),
+		)
+		return
+	}
+
+	state.ZoneName = types.StringValue(zones[0].Name)
+
+	filters := map[string]string{}
+	if !state.RecordType.IsNull() {
+		filters["record_type"] = strings.ToUpper(state.RecordType.ValueString())
+	}
+	if !state.RecordHost.IsNull() {
+		filters["record_host"] = state.RecordHost.ValueString()
+	}
+	if !state.RecordValue.IsNull() {
+		filters["record_value"] = state.RecordValue.ValueString()
+	}
+
+	records, err := d.client.DNS.GetZoneRecords(state.ZoneID.ValueString(), &filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision DNS Records",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response body to model, the filters are applied again in case the
+	// server ignored any of them.
+	for _, record := range records {
+		if !state.RecordType.IsNull() && !strings.EqualFold(record.RecordType, state.RecordType.ValueString()) {
+			continue
+		}
+		if !state.RecordHost.IsNull() && canonicalFQDN(record.RecordHost) != canonicalFQDN(state.RecordHost.ValueString()) {
+			continue
+		}
+		if !state.RecordValue.IsNull() && record.RecordValue != state.RecordValue.ValueString() {
+			continue
+		}
+
+		state.Records = append(state.Records, dnsrecordsModel{
+			ID:          types.StringValue(string(record.ID)),
+			Name:        types.StringValue(record.Name),
+			Modified:    types.StringValue(record.Modified),
+			Status:      types.StringValue(record.Status),
+			RecordType:  types.StringValue(record.RecordType),
+			RecordHost:  types.StringValue(record.RecordHost),
+			RecordValue: types.StringValue(record.RecordValue),
+			RecordTTL:   types.Int64Value(int64(record.RecordTTL)),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
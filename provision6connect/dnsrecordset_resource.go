@@ -0,0 +1,431 @@
+package provision6connect
+
+import (
+	"context"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsrecordsetResource{}
+	_ resource.ResourceWithConfigure      = &dnsrecordsetResource{}
+	_ resource.ResourceWithImportState    = &dnsrecordsetResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsrecordsetResource{}
+	_ resource.ResourceWithValidateConfig = &dnsrecordsetResource{}
+)
+
+// NewDNSrecordsetResource is a helper function to simplify the provider implementation.
+func NewDNSrecordsetResource() resource.Resource {
+	return &dnsrecordsetResource{}
+}
+
+// dnsrecordsetModel maps DNS record set schema data.
+type dnsrecordsetModel struct {
+	ID         types.String `tfsdk:"id"`
+	ZoneID     types.String `tfsdk:"zone_id"`
+	RecordHost types.String `tfsdk:"record_host"`
+	RecordType types.String `tfsdk:"record_type"`
+	Name       types.String `tfsdk:"name"`
+	RecordTTL  types.Int64  `tfsdk:"record_ttl"`
+	Values     types.Set    `tfsdk:"values"`
+	RecordIDs  types.Map    `tfsdk:"record_ids"`
+}
+
+// dnsrecordsetResource is the resource implementation.
+type dnsrecordsetResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsrecordsetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsrecordsetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_recordset"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsrecordsetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS Record Set Resource that manages every DNS Record of one host and type in a ProVision DNS Zone, for Example round-robin A records or multi-value TXT records. " +
+			"Records that already exist for the host and type are not taken over on creation, they must be imported with <zone_id>/<record_host>/<record_type>.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the DNS Record Set in the format <zone_id>/<record_host>/<record_type>.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone that contains the DNS Records.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"record_host": schema.StringAttribute{
+				Description: "FQDN of the DNS Records ending with a dot and inside the DNS Zone Ex: www.example.com.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"record_type": schema.StringAttribute{
+				Description: "DNS Record Type Ex: A, AAAA, TXT, NS",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Pretty name given to every DNS Record of the set, record_host is used if it is not set.",
+				Optional:    true,
+			},
+			"record_ttl": schema.Int64Attribute{
+				Description: "DNS Record TTL of every DNS Record of the set Ex: 900",
+				Required:    true,
+			},
+			"values": schema.SetAttribute{
+				Description: "DNS Record Values of the set, one DNS Record is managed for each of them Ex: [\"192.0.2.1\", \"192.0.2.2\"]",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"record_ids": schema.MapAttribute{
+				Description: "Numeric identifier of the DNS Record managed for each value",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the record host and values for the record type.
+func (r *dnsrecordsetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dnsrecordsetModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.RecordHost.IsUnknown() {
+		if err := validateDNSName(config.RecordHost.ValueString(), true, true); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("record_host"),
+				"Invalid DNS Record Host",
+				"record_host "+err.Error(),
+			)
+		}
+	}
+
+	if !config.RecordTTL.IsUnknown() && (config.RecordTTL.ValueInt64() < 0 || config.RecordTTL.ValueInt64() > 2147483647) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("record_ttl"),
+			"Invalid DNS Record TTL",
+			"record_ttl must be between 0 and 2147483647.",
+		)
+	}
+
+	if config.RecordType.IsUnknown() || config.Values.IsUnknown() {
+		return
+	}
+
+	if !knownDNSRecordType(config.RecordType.ValueString()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("record_type"),
+			"Unvalidated DNS Record Type",
+			"The value of "+config.RecordType.ValueString()+" records is not validated by the provider, the supported types are "+strings.Join(dnsRecordTypes, ", ")+".",
+		)
+		return
+	}
+
+	var values []types.String
+	resp.Diagnostics.Append(config.Values.ElementsAs(ctx, &values, false)...)
+	for i, value := range values {
+		if value.IsUnknown() {
+			continue
+		}
+		if _, err := normalizeDNSRecordValue(config.RecordType.ValueString(), value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("values"),
+				"Invalid DNS Record Value",
+				err.Error(),
+			)
+		}
+		for _, other := range values[:i] {
+			if !other.IsUnknown() && dnsRecordValuesEqual(config.RecordType.ValueString(), other.ValueString(), value.ValueString()) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("values"),
+					"Duplicate DNS Record Value",
+					"The values "+other.ValueString()+" and "+value.ValueString()+" are the same DNS Record.",
+				)
+			}
+		}
+	}
+}
+
+// ModifyPlan makes sure the record host is inside the zone of the record set.
+func (r *dnsrecordsetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan dnsrecordsetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.ZoneID.IsUnknown() || plan.RecordHost.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkRecordHostInZone(&r.client.DNS, plan.ZoneID.ValueString(), plan.RecordHost.ValueString())...)
+}
+
+// converge adds, updates and deletes the records of the set to match the plan.
+func (r *dnsrecordsetResource) converge(ctx context.Context, plan *dnsrecordsetModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var values []string
+	diags.Append(plan.Values.ElementsAs(ctx, &values, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := findDNSRecords(&r.client.DNS, plan.ZoneID.ValueString(), plan.RecordHost.ValueString(), plan.RecordType.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error Reading ProVision DNS Records",
+			"Could not read the ProVision DNS Records of "+plan.RecordHost.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	name := plan.Name.ValueString()
+	if plan.Name.IsNull() {
+		name = plan.RecordHost.ValueString()
+	}
+
+	desired := make([]provisionclient.DNSRecord, 0, len(values))
+	for _, value := range values {
+		desired = append(desired, provisionclient.DNSRecord{
+			Name:        name,
+			ParentID:    provisionclient.PVID(plan.ZoneID.ValueString()),
+			RecordType:  plan.RecordType.ValueString(),
+			RecordHost:  plan.RecordHost.ValueString(),
+			RecordValue: value,
+			RecordTTL:   int(plan.RecordTTL.ValueInt64()),
+		})
+	}
+
+	records, err := syncDNSRecords(&r.client.DNS, current, desired)
+	if err != nil {
+		diags.AddError(
+			"Error Updating ProVision DNS Record Set",
+			"Could not update the ProVision DNS Records of "+plan.RecordHost.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	ids := make(map[string]string, len(values))
+	for i, value := range values {
+		ids[value] = string(records[i].ID)
+	}
+
+	var d diag.Diagnostics
+	plan.RecordIDs, d = types.MapValueFrom(ctx, types.StringType, ids)
+	diags.Append(d...)
+	return diags
+}
+
+// Create a new resource
+func (r *dnsrecordsetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsrecordsetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Records already in the zone for the host and type are only taken over by an import
+	existing, err := findDNSRecords(&r.client.DNS, plan.ZoneID.ValueString(), plan.RecordHost.ValueString(), plan.RecordType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Records",
+			"Could not read the ProVision DNS Records of "+plan.RecordHost.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if len(existing) != 0 {
+		resp.Diagnostics.AddError(
+			"ProVision DNS Records Already Exist",
+			"The DNS Zone already has "+plan.RecordType.ValueString()+" records for "+plan.RecordHost.ValueString()+", import them with "+
+				plan.ZoneID.ValueString()+"/"+plan.RecordHost.ValueString()+"/"+plan.RecordType.ValueString()+" to manage them in the record set.",
+		)
+		return
+	}
+
+	// Create new records
+	resp.Diagnostics.Append(r.converge(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(plan.ZoneID.ValueString() + "/" + plan.RecordHost.ValueString() + "/" + plan.RecordType.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnsrecordsetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save it split into zone_id, record_host and record_type
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: <zone_id>/<record_host>/<record_type>. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("record_host"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("record_type"), parts[2])...)
+}
+
+// Read resource information
+func (r *dnsrecordsetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsrecordsetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := findDNSRecords(&r.client.DNS, state.ZoneID.ValueString(), state.RecordHost.ValueString(), state.RecordType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Record Set",
+			"Could not read the ProVision DNS Records of "+state.RecordHost.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var current []string
+	if !state.Values.IsNull() {
+		resp.Diagnostics.Append(state.Values.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Values that only differ in their format from the ones in state are kept
+	values := make([]string, 0, len(records))
+	ids := make(map[string]string, len(records))
+	for _, record := range records {
+		value := record.RecordValue
+		for _, existing := range current {
+			if dnsRecordValuesEqual(record.RecordType, existing, record.RecordValue) {
+				value = existing
+				break
+			}
+		}
+		values = append(values, value)
+		ids[value] = string(record.ID)
+	}
+
+	if len(records) != 0 {
+		state.RecordTTL = types.Int64Value(int64(records[0].RecordTTL))
+		if !state.Name.IsNull() {
+			state.Name = types.StringValue(records[0].Name)
+		}
+	}
+
+	state.Values, diags = types.SetValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	state.RecordIDs, diags = types.MapValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnsrecordsetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsrecordsetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating DNS Record Set "+plan.ID.ValueString())
+
+	// Update existing records
+	resp.Diagnostics.Append(r.converge(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsrecordsetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsrecordsetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := map[string]string{}
+	resp.Diagnostics.Append(state.RecordIDs.ElementsAs(ctx, &ids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete the managed records, other records of the host and type are left alone
+	for _, id := range ids {
+		err := r.client.DNS.DeleteZoneRecordByID(state.ZoneID.ValueString(), id)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting ProVision DNS Record Set",
+				"Could not delete ProVision DNS Record ID "+id+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
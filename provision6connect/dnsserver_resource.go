@@ -0,0 +1,327 @@
+package provision6connect
+
+import (
+	"context"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsserverResource{}
+	_ resource.ResourceWithConfigure   = &dnsserverResource{}
+	_ resource.ResourceWithImportState = &dnsserverResource{}
+)
+
+// NewDNSserverResource is a helper function to simplify the provider implementation.
+func NewDNSserverResource() resource.Resource {
+	return &dnsserverResource{}
+}
+
+// dnsserverModel maps DNS server schema data.
+type dnsserverModel struct {
+	ID             types.String `tfsdk:"id"`
+	ParentID       types.String `tfsdk:"parent_id"`
+	Name           types.String `tfsdk:"name"`
+	ServerType     types.String `tfsdk:"server_type"`
+	Host           types.String `tfsdk:"host"`
+	Port           types.Int64  `tfsdk:"port"`
+	ConnectionType types.String `tfsdk:"connection_type"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	APIKey         types.String `tfsdk:"api_key"`
+	ConfigPath     types.String `tfsdk:"config_path"`
+	Status         types.String `tfsdk:"status"`
+	Modified       types.String `tfsdk:"modified"`
+}
+
+// optionalStringValue maps a server value into an Optional attribute, keeping
+// it null when it was not configured and the server did not return a value.
+func optionalStringValue(current types.String, value string) types.String {
+	if value == "" && current.IsNull() {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func dnsserverToState(state *dnsserverModel, server *dnsServer) {
+	state.ID = types.StringValue(string(server.ID))
+	state.ParentID = types.StringValue(string(server.ParentID))
+	state.Name = types.StringValue(server.Name)
+	state.ServerType = types.StringValue(server.ServerType)
+	state.Host = types.StringValue(server.Host)
+	state.Port = types.Int64Value(int64(server.Port))
+	state.ConnectionType = types.StringValue(server.ConnectionType)
+	state.Username = optionalStringValue(state.Username, server.Username)
+	state.ConfigPath = optionalStringValue(state.ConfigPath, server.ConfigPath)
+	state.Status = types.StringValue(server.Status)
+	state.Modified = types.StringValue(server.Modified)
+}
+
+// dnsserverResource is the resource implementation.
+type dnsserverResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsserverResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsserverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_server"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsserverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS Server Resource that represents a DNS server ProVision pushes zones to",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Server.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Parent Resource ID for the DNS Server, if it is not set ProVision will set TLR by default.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "DNS Server Name",
+				Required:    true,
+			},
+			"server_type": schema.StringAttribute{
+				Description: "DNS Server software, it can be bind, powerdns, nsd, knot or microsoft",
+				Required:    true,
+				Validators: []validator.String{
+					stringOneOf("bind", "powerdns", "nsd", "knot", "microsoft"),
+				},
+			},
+			"host": schema.StringAttribute{
+				Description: "Hostname or IP Address ProVision uses to connect to the DNS Server",
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port ProVision uses to connect to the DNS Server, Ex: 22 for ssh or 8081 for the PowerDNS API",
+				Optional:    true,
+				Computed:    true,
+			},
+			"connection_type": schema.StringAttribute{
+				Description: "Connection method used to push the configuration, it can be ssh, api or local",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringOneOf("ssh", "api", "local"),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Username used to connect to the DNS Server",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password used to connect to the DNS Server",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_key": schema.StringAttribute{
+				Description: "API Key used to connect to API based DNS Servers like PowerDNS",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"config_path": schema.StringAttribute{
+				Description: "Path of the DNS Server configuration directory Ex: /etc/bind",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Current status set by ProVision of the DNS Server",
+				Computed:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "Date and Time of the last modification",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource
+func (r *dnsserverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsserverModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newServer := dnsServer{
+		Name:       plan.Name.ValueString(),
+		ServerType: plan.ServerType.ValueString(),
+		Host:       plan.Host.ValueString(),
+		Username:   plan.Username.ValueString(),
+		Password:   plan.Password.ValueString(),
+		APIKey:     plan.APIKey.ValueString(),
+		ConfigPath: plan.ConfigPath.ValueString(),
+	}
+
+	if !plan.ParentID.IsUnknown() {
+		newServer.ParentID = provisionclient.PVID(plan.ParentID.ValueString())
+	}
+	if !plan.Port.IsUnknown() {
+		newServer.Port = int(plan.Port.ValueInt64())
+	}
+	if !plan.ConnectionType.IsUnknown() {
+		newServer.ConnectionType = plan.ConnectionType.ValueString()
+	}
+
+	// Create new server
+	server, err := addDNSServer(&r.client.DNS, newServer)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new ProVision DNS Server",
+			"Could not create ProVision DNS Server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	dnsserverToState(&plan, server)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnsserverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Read resource information
+func (r *dnsserverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsserverModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	servers, err := getDNSServerByID(&r.client.DNS, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Server",
+			"Could not read ProVision DNS Server ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(servers) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision DNS Server",
+			"ProVision DNS Server has not been found ID "+state.ID.ValueString(),
+		)
+		return
+	}
+
+	// Credentials are never returned by ProVision, the state values are kept.
+	dnsserverToState(&state, &servers[0])
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnsserverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsserverModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating DNS Server ID "+plan.ID.ValueString())
+
+	newServer := dnsServer{
+		ID:         provisionclient.PVID(plan.ID.ValueString()),
+		Name:       plan.Name.ValueString(),
+		ServerType: plan.ServerType.ValueString(),
+		Host:       plan.Host.ValueString(),
+		Username:   plan.Username.ValueString(),
+		Password:   plan.Password.ValueString(),
+		APIKey:     plan.APIKey.ValueString(),
+		ConfigPath: plan.ConfigPath.ValueString(),
+	}
+
+	if !plan.ParentID.IsUnknown() {
+		newServer.ParentID = provisionclient.PVID(plan.ParentID.ValueString())
+	}
+	if !plan.Port.IsUnknown() {
+		newServer.Port = int(plan.Port.ValueInt64())
+	}
+	if !plan.ConnectionType.IsUnknown() {
+		newServer.ConnectionType = plan.ConnectionType.ValueString()
+	}
+
+	// Update existing server
+	server, err := updateDNSServer(&r.client.DNS, newServer)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating ProVision DNS Server",
+			"Could not update ProVision DNS Server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	dnsserverToState(&plan, server)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsserverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsserverModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing server
+	err := deleteDNSServerByID(&r.client.DNS, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision DNS Server",
+			"Could not delete ProVision DNS Server, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -0,0 +1,205 @@
+package provision6connect
+
+import (
+	"context"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnszoneDataSourceModel maps the data source schema data.
+type dnszoneDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	GroupID     types.String `tfsdk:"group_id"`
+	ParentID    types.String `tfsdk:"parent_id"`
+	Status      types.String `tfsdk:"status"`
+	ZoneType    types.String `tfsdk:"zone_type"`
+	ZoneExpire  types.Int64  `tfsdk:"zone_expire"`
+	ZoneHost    types.String `tfsdk:"zone_host"`
+	ZoneMail    types.String `tfsdk:"zone_mail"`
+	ZoneMinimum types.Int64  `tfsdk:"zone_minimum"`
+	ZoneRefresh types.Int64  `tfsdk:"zone_refresh"`
+	ZoneRetry   types.Int64  `tfsdk:"zone_retry"`
+	ZoneSerial  types.Int64  `tfsdk:"zone_serial"`
+	ZoneTTL     types.Int64  `tfsdk:"zone_ttl"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnszoneDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnszoneDataSource{}
+)
+
+// NewDNSzoneDataSource is a helper function to simplify the provider implementation.
+func NewDNSzoneDataSource() datasource.DataSource {
+	return &dnszoneDataSource{}
+}
+
+// dnszoneDataSource is the data source implementation.
+type dnszoneDataSource struct {
+	client *provisionclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnszoneDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnszone"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dnszoneDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *dnszoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS Zone Data Source for looking up an existing ProVision DNS Zone by id or name. Either id or name must be specified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone to look up",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "DNS Zone Name to look up in FQDN format, for Example: example.com.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Group Identifier used to narrow down the name lookup when the zone exists in several groups",
+				Optional:    true,
+				Computed:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Parent Resource ID of the Zone",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Current status set by ProVision of the DNS Zone",
+				Computed:    true,
+			},
+			"zone_type": schema.StringAttribute{
+				Description: "Type for the current zone, it can be forward or reverse",
+				Computed:    true,
+			},
+			"zone_expire": schema.Int64Attribute{
+				Description: "DNS Zone Expire Time",
+				Computed:    true,
+			},
+			"zone_host": schema.StringAttribute{
+				Description: "DNS Zone Host in FQDN format",
+				Computed:    true,
+			},
+			"zone_mail": schema.StringAttribute{
+				Description: "DNS Zone Mail in FQDN format",
+				Computed:    true,
+			},
+			"zone_minimum": schema.Int64Attribute{
+				Description: "DNS Zone Minimum Time",
+				Computed:    true,
+			},
+			"zone_refresh": schema.Int64Attribute{
+				Description: "DNS Zone Refresh Time",
+				Computed:    true,
+			},
+			"zone_retry": schema.Int64Attribute{
+				Description: "DNS Zone Retry Time",
+				Computed:    true,
+			},
+			"zone_serial": schema.Int64Attribute{
+				Description: "DNS Zone Serial",
+				Computed:    true,
+			},
+			"zone_ttl": schema.Int64Attribute{
+				Description: "DNS Zone TTL",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnszoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnszoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var zones []provisionclient.DNSZone
+	var err error
+
+	if !state.ID.IsNull() {
+		zones, err = d.client.DNS.GetZoneByID(state.ID.ValueString())
+	} else if !state.Name.IsNull() {
+		zones, err = findDNSZonesByName(&d.client.DNS, state.Name.ValueString(), state.GroupID.ValueString())
+	} else {
+		resp.Diagnostics.AddError(
+			"Either id or name are required",
+			"Either id or name are required",
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision DNS Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	if len(zones) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision DNS Zone",
+			"ProVision DNS Zone has not been found",
+		)
+		return
+	}
+
+	if len(zones) > 1 {
+		groups := make([]string, 0, len(zones))
+		for _, zone := range zones {
+			groups = append(groups, string(zone.GroupID))
+		}
+		resp.Diagnostics.AddError(
+			"Multiple ProVision DNS Zones Found",
+			"The DNS Zone "+state.Name.ValueString()+" exists in several groups ("+strings.Join(groups, ", ")+"), set group_id to select one of them.",
+		)
+		return
+	}
+
+	dnszone := zones[0]
+
+	state.ID = types.StringValue(string(dnszone.ID))
+	state.Name = types.StringValue(dnszone.Name)
+	state.GroupID = types.StringValue(string(dnszone.GroupID))
+	state.ParentID = types.StringValue(string(dnszone.ParentID))
+	state.Status = types.StringValue(dnszone.Status)
+	state.ZoneType = types.StringValue(dnszone.ZoneType)
+	state.ZoneHost = types.StringValue(dnszone.ZoneHost)
+	state.ZoneMail = types.StringValue(dnszone.ZoneMail)
+	state.ZoneExpire = types.Int64Value(int64(dnszone.ZoneExpire))
+	state.ZoneMinimum = types.Int64Value(int64(dnszone.ZoneMinimum))
+	state.ZoneRefresh = types.Int64Value(int64(dnszone.ZoneRefresh))
+	state.ZoneRetry = types.Int64Value(int64(dnszone.ZoneRetry))
+	state.ZoneSerial = types.Int64Value(int64(dnszone.ZoneSerial))
+	state.ZoneTTL = types.Int64Value(int64(dnszone.ZoneTTL))
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,201 @@
+package provision6connect
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DNSSEC signing algorithms supported by ProVision.
+const (
+	dnssecAlgorithmRSASHA256       = "RSASHA256"
+	dnssecAlgorithmRSASHA512       = "RSASHA512"
+	dnssecAlgorithmECDSAP256SHA256 = "ECDSAP256SHA256"
+	dnssecAlgorithmECDSAP384SHA384 = "ECDSAP384SHA384"
+	dnssecAlgorithmED25519         = "ED25519"
+)
+
+// dnsZoneDNSSEC is the DNSSEC signing configuration of a zone as returned by the
+// ProVision DNS zones API. The DS records are only returned once the zone is signed.
+type dnsZoneDNSSEC struct {
+	Enabled     bool     `json:"dnssec_enabled"`
+	Algorithm   string   `json:"algorithm,omitempty"`
+	KSKSize     int      `json:"ksk_size,string,omitempty"`
+	ZSKSize     int      `json:"zsk_size,string,omitempty"`
+	KSKRollover int      `json:"ksk_rollover_days,string,omitempty"`
+	ZSKRollover int      `json:"zsk_rollover_days,string,omitempty"`
+	DSRecords   []string `json:"ds_records,omitempty"`
+}
+
+func getDNSZoneDNSSEC(dns *provisionclient.DNSMethods, zoneID string) (*dnsZoneDNSSEC, error) {
+	var dnssec dnsZoneDNSSEC
+	err := apiRequest(dns.Client, "GET", "/dns/zones/"+zoneID+"/dnssec", nil, nil, &dnssec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dnssec, nil
+}
+
+func updateDNSZoneDNSSEC(dns *provisionclient.DNSMethods, zoneID string, dnssec dnsZoneDNSSEC) (*dnsZoneDNSSEC, error) {
+	var resp_dnssec dnsZoneDNSSEC
+	err := apiRequest(dns.Client, "PATCH", "/dns/zones/"+zoneID+"/dnssec", nil, dnssec, &resp_dnssec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_dnssec, nil
+}
+
+// dnssecRSAAlgorithm reports whether the algorithm key sizes can be chosen.
+func dnssecRSAAlgorithm(algorithm string) bool {
+	return strings.HasPrefix(algorithm, "RSA")
+}
+
+// validateDNSSECConfig checks the DNSSEC settings of the zone configuration.
+func validateDNSSECConfig(config dnszoneModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	settings := map[string]bool{
+		"dnssec_algorithm":         !config.DNSSECAlgorithm.IsNull(),
+		"dnssec_ksk_size":          !config.DNSSECKSKSize.IsNull(),
+		"dnssec_zsk_size":          !config.DNSSECZSKSize.IsNull(),
+		"dnssec_ksk_rollover_days": !config.DNSSECKSKRollover.IsNull(),
+		"dnssec_zsk_rollover_days": !config.DNSSECZSKRollover.IsNull(),
+	}
+	if !config.DNSSECEnabled.IsUnknown() && !config.DNSSECEnabled.ValueBool() {
+		for name, set := range settings {
+			if set {
+				diags.AddAttributeError(
+					path.Root(name),
+					"DNSSEC Is Not Enabled",
+					name+" can only be set when dnssec_enabled is true.",
+				)
+			}
+		}
+		return diags
+	}
+
+	algorithm := config.DNSSECAlgorithm
+	if !algorithm.IsNull() && !algorithm.IsUnknown() && !dnssecRSAAlgorithm(algorithm.ValueString()) {
+		for _, name := range []string{"dnssec_ksk_size", "dnssec_zsk_size"} {
+			if settings[name] {
+				diags.AddAttributeError(
+					path.Root(name),
+					"Invalid DNSSEC Key Size",
+					name+" can only be set for RSA algorithms, the key size of "+algorithm.ValueString()+" is fixed.",
+				)
+			}
+		}
+	}
+
+	for name, size := range map[string]types.Int64{"dnssec_ksk_size": config.DNSSECKSKSize, "dnssec_zsk_size": config.DNSSECZSKSize} {
+		if size.IsNull() || size.IsUnknown() {
+			continue
+		}
+		if size.ValueInt64() < 1024 || size.ValueInt64() > 4096 {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid DNSSEC Key Size",
+				name+" must be between 1024 and 4096 bits, got "+strconv.FormatInt(size.ValueInt64(), 10)+".",
+			)
+		}
+	}
+
+	for name, days := range map[string]types.Int64{"dnssec_ksk_rollover_days": config.DNSSECKSKRollover, "dnssec_zsk_rollover_days": config.DNSSECZSKRollover} {
+		if !days.IsNull() && !days.IsUnknown() && days.ValueInt64() < 1 {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid DNSSEC Rollover Period",
+				name+" must be at least 1 day.",
+			)
+		}
+	}
+
+	return diags
+}
+
+// dnssecChanged reports whether the DNSSEC settings of the plan differ from the state.
+func dnssecChanged(plan, state dnszoneModel) bool {
+	return !plan.DNSSECEnabled.Equal(state.DNSSECEnabled) ||
+		!plan.DNSSECAlgorithm.Equal(state.DNSSECAlgorithm) ||
+		!plan.DNSSECKSKSize.Equal(state.DNSSECKSKSize) ||
+		!plan.DNSSECZSKSize.Equal(state.DNSSECZSKSize) ||
+		!plan.DNSSECKSKRollover.Equal(state.DNSSECKSKRollover) ||
+		!plan.DNSSECZSKRollover.Equal(state.DNSSECZSKRollover)
+}
+
+// dnssecFromPlan returns the DNSSEC settings to send for the plan, settings left
+// to ProVision are not sent.
+func dnssecFromPlan(plan dnszoneModel) dnsZoneDNSSEC {
+	dnssec := dnsZoneDNSSEC{
+		Enabled: plan.DNSSECEnabled.ValueBool(),
+	}
+	if !dnssec.Enabled {
+		return dnssec
+	}
+
+	if !plan.DNSSECAlgorithm.IsNull() && !plan.DNSSECAlgorithm.IsUnknown() {
+		dnssec.Algorithm = plan.DNSSECAlgorithm.ValueString()
+	}
+	if !plan.DNSSECKSKSize.IsNull() && !plan.DNSSECKSKSize.IsUnknown() {
+		dnssec.KSKSize = int(plan.DNSSECKSKSize.ValueInt64())
+	}
+	if !plan.DNSSECZSKSize.IsNull() && !plan.DNSSECZSKSize.IsUnknown() {
+		dnssec.ZSKSize = int(plan.DNSSECZSKSize.ValueInt64())
+	}
+	if !plan.DNSSECKSKRollover.IsNull() && !plan.DNSSECKSKRollover.IsUnknown() {
+		dnssec.KSKRollover = int(plan.DNSSECKSKRollover.ValueInt64())
+	}
+	if !plan.DNSSECZSKRollover.IsNull() && !plan.DNSSECZSKRollover.IsUnknown() {
+		dnssec.ZSKRollover = int(plan.DNSSECZSKRollover.ValueInt64())
+	}
+	return dnssec
+}
+
+// dnssecToState maps the DNSSEC settings read from ProVision to the model. The
+// enable flag is only set when it is configured or DNSSEC has been enabled outside
+// of Terraform, so zones without DNSSEC do not show a diff.
+func dnssecToState(ctx context.Context, model *dnszoneModel, dnssec *dnsZoneDNSSEC) diag.Diagnostics {
+	if dnssec == nil {
+		dnssec = &dnsZoneDNSSEC{}
+	}
+
+	if !model.DNSSECEnabled.IsNull() || dnssec.Enabled {
+		model.DNSSECEnabled = types.BoolValue(dnssec.Enabled)
+	}
+
+	model.DNSSECAlgorithm = types.StringNull()
+	if dnssec.Algorithm != "" {
+		model.DNSSECAlgorithm = types.StringValue(dnssec.Algorithm)
+	}
+	model.DNSSECKSKSize = types.Int64Null()
+	if dnssec.KSKSize != 0 {
+		model.DNSSECKSKSize = types.Int64Value(int64(dnssec.KSKSize))
+	}
+	model.DNSSECZSKSize = types.Int64Null()
+	if dnssec.ZSKSize != 0 {
+		model.DNSSECZSKSize = types.Int64Value(int64(dnssec.ZSKSize))
+	}
+	model.DNSSECKSKRollover = types.Int64Null()
+	if dnssec.KSKRollover != 0 {
+		model.DNSSECKSKRollover = types.Int64Value(int64(dnssec.KSKRollover))
+	}
+	model.DNSSECZSKRollover = types.Int64Null()
+	if dnssec.ZSKRollover != 0 {
+		model.DNSSECZSKRollover = types.Int64Value(int64(dnssec.ZSKRollover))
+	}
+
+	dsRecords := dnssec.DSRecords
+	if !dnssec.Enabled || dsRecords == nil {
+		dsRecords = []string{}
+	}
+	var diags diag.Diagnostics
+	model.DSRecords, diags = types.ListValueFrom(ctx, types.StringType, dsRecords)
+	return diags
+}
@@ -0,0 +1,284 @@
+package provision6connect
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Roles of a DNS zone on the servers it is pushed to.
+const (
+	zoneRolePrimary   = "primary"
+	zoneRoleSecondary = "secondary"
+	zoneRoleForward   = "forward"
+)
+
+// dnsZoneOptions are the view, transfer and query settings of a zone as returned by
+// the ProVision DNS zones API. Lists are always sent so they can be cleared.
+type dnsZoneOptions struct {
+	View          string   `json:"view"`
+	ZoneRole      string   `json:"zone_role,omitempty"`
+	AllowTransfer []string `json:"allow_transfer"`
+	AllowQuery    []string `json:"allow_query"`
+	AlsoNotify    []string `json:"also_notify"`
+	Masters       []string `json:"masters"`
+	Forwarders    []string `json:"forwarders"`
+}
+
+func getDNSZoneOptions(dns *provisionclient.DNSMethods, zoneID string) (*dnsZoneOptions, error) {
+	var options dnsZoneOptions
+	err := apiRequest(dns.Client, "GET", "/dns/zones/"+zoneID+"/options", nil, nil, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &options, nil
+}
+
+func updateDNSZoneOptions(dns *provisionclient.DNSMethods, zoneID string, options dnsZoneOptions) (*dnsZoneOptions, error) {
+	var resp_options dnsZoneOptions
+	err := apiRequest(dns.Client, "PATCH", "/dns/zones/"+zoneID+"/options", nil, options, &resp_options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_options, nil
+}
+
+// validateAddressMatch checks an element of a BIND address match list, an address,
+// a prefix, one of the predefined lists or a TSIG key, optionally negated with !.
+func validateAddressMatch(value string) error {
+	element := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(value), "!"))
+
+	switch element {
+	case "any", "none", "localhost", "localnets":
+		return nil
+	}
+
+	if strings.HasPrefix(element, "key ") {
+		if strings.TrimSpace(strings.TrimPrefix(element, "key ")) == "" {
+			return fmt.Errorf("%q must name a TSIG key", value)
+		}
+		return nil
+	}
+
+	if _, err := netip.ParseAddr(element); err == nil {
+		return nil
+	}
+	if _, err := netip.ParsePrefix(element); err == nil {
+		return nil
+	}
+	return fmt.Errorf("%q must be an IP address, a prefix, any, none, localhost, localnets or key <name>", value)
+}
+
+// validateServerAddress checks the address of a primary, notified or forwarder server.
+func validateServerAddress(value string) error {
+	if _, err := netip.ParseAddr(value); err != nil {
+		return fmt.Errorf("%q must be an IP address", value)
+	}
+	return nil
+}
+
+// zoneOptionLists returns the list attributes of the zone options with their validation.
+func zoneOptionLists(model dnszoneModel) []struct {
+	name     string
+	value    types.List
+	validate func(string) error
+} {
+	return []struct {
+		name     string
+		value    types.List
+		validate func(string) error
+	}{
+		{"allow_transfer", model.AllowTransfer, validateAddressMatch},
+		{"allow_query", model.AllowQuery, validateAddressMatch},
+		{"also_notify", model.AlsoNotify, validateServerAddress},
+		{"masters", model.Masters, validateServerAddress},
+		{"forwarders", model.Forwarders, validateServerAddress},
+	}
+}
+
+// validateZoneOptionsConfig checks the list entries and the settings required or
+// refused by each zone_role.
+func validateZoneOptionsConfig(ctx context.Context, config dnszoneModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, list := range zoneOptionLists(config) {
+		if list.value.IsNull() || list.value.IsUnknown() {
+			continue
+		}
+
+		var elements []types.String
+		diags.Append(list.value.ElementsAs(ctx, &elements, false)...)
+		for _, element := range elements {
+			if element.IsUnknown() || element.IsNull() {
+				continue
+			}
+			if err := list.validate(element.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root(list.name),
+					"Invalid DNS Zone "+list.name+" Entry",
+					list.name+" entry "+err.Error(),
+				)
+			}
+		}
+	}
+
+	if config.ZoneRole.IsUnknown() {
+		return diags
+	}
+
+	role := config.ZoneRole.ValueString()
+	if config.ZoneRole.IsNull() {
+		role = zoneRolePrimary
+	}
+
+	// Lists that can not be empty for the role, and lists the role does not use
+	required := map[string][]string{
+		zoneRoleSecondary: {"masters"},
+		zoneRoleForward:   {"forwarders"},
+	}
+	refused := map[string][]string{
+		zoneRolePrimary:   {"masters", "forwarders"},
+		zoneRoleSecondary: {"forwarders"},
+		zoneRoleForward:   {"masters", "allow_transfer", "also_notify"},
+	}
+
+	values := map[string]types.List{}
+	for _, list := range zoneOptionLists(config) {
+		values[list.name] = list.value
+	}
+
+	for _, name := range required[role] {
+		value := values[name]
+		if value.IsUnknown() {
+			continue
+		}
+		if value.IsNull() || len(value.Elements()) == 0 {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Missing DNS Zone "+name,
+				name+" must list at least one server when zone_role is "+role+".",
+			)
+		}
+	}
+
+	for _, name := range refused[role] {
+		if !values[name].IsNull() {
+			diags.AddAttributeError(
+				path.Root(name),
+				"Invalid DNS Zone "+name,
+				name+" can not be set when zone_role is "+role+".",
+			)
+		}
+	}
+
+	return diags
+}
+
+// zoneOptionsConfigured reports whether any of the zone options is set in the model.
+func zoneOptionsConfigured(model dnszoneModel) bool {
+	if !model.View.IsNull() || !model.ZoneRole.IsNull() {
+		return true
+	}
+	for _, list := range zoneOptionLists(model) {
+		if !list.value.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// zoneOptionsChanged reports whether the zone options of the plan differ from the state.
+func zoneOptionsChanged(plan, state dnszoneModel) bool {
+	if !plan.View.Equal(state.View) || !plan.ZoneRole.Equal(state.ZoneRole) {
+		return true
+	}
+	stateLists := zoneOptionLists(state)
+	for i, list := range zoneOptionLists(plan) {
+		if !list.value.Equal(stateLists[i].value) {
+			return true
+		}
+	}
+	return false
+}
+
+// zoneOptionsFromPlan returns the zone options to send for the plan, unset lists are
+// sent empty so they are cleared.
+func zoneOptionsFromPlan(ctx context.Context, plan dnszoneModel) (dnsZoneOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := dnsZoneOptions{
+		View:     plan.View.ValueString(),
+		ZoneRole: plan.ZoneRole.ValueString(),
+	}
+	if options.ZoneRole == "" {
+		options.ZoneRole = zoneRolePrimary
+	}
+
+	for _, list := range []struct {
+		value  types.List
+		target *[]string
+	}{
+		{plan.AllowTransfer, &options.AllowTransfer},
+		{plan.AllowQuery, &options.AllowQuery},
+		{plan.AlsoNotify, &options.AlsoNotify},
+		{plan.Masters, &options.Masters},
+		{plan.Forwarders, &options.Forwarders},
+	} {
+		*list.target = []string{}
+		if !list.value.IsNull() {
+			diags.Append(list.value.ElementsAs(ctx, list.target, false)...)
+		}
+	}
+
+	return options, diags
+}
+
+// zoneOptionsToState maps the zone options read from ProVision to the model. Empty
+// values stay null when they are not set in the model, so zones without options do
+// not show a diff.
+func zoneOptionsToState(ctx context.Context, model *dnszoneModel, options *dnsZoneOptions) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if options.View != "" || !model.View.IsNull() {
+		model.View = types.StringValue(options.View)
+	}
+	if (options.ZoneRole != "" && options.ZoneRole != zoneRolePrimary) || !model.ZoneRole.IsNull() {
+		role := options.ZoneRole
+		if role == "" {
+			role = zoneRolePrimary
+		}
+		model.ZoneRole = types.StringValue(role)
+	}
+
+	for _, list := range []struct {
+		target *types.List
+		values []string
+	}{
+		{&model.AllowTransfer, options.AllowTransfer},
+		{&model.AllowQuery, options.AllowQuery},
+		{&model.AlsoNotify, options.AlsoNotify},
+		{&model.Masters, options.Masters},
+		{&model.Forwarders, options.Forwarders},
+	} {
+		if len(list.values) == 0 && list.target.IsNull() {
+			continue
+		}
+		values := list.values
+		if values == nil {
+			values = []string{}
+		}
+		var d diag.Diagnostics
+		*list.target, d = types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+	}
+
+	return diags
+}
@@ -2,22 +2,30 @@ package provision6connect
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &dnszoneResource{}
-	_ resource.ResourceWithConfigure   = &dnszoneResource{}
-	_ resource.ResourceWithImportState = &dnszoneResource{}
+	_ resource.Resource                   = &dnszoneResource{}
+	_ resource.ResourceWithConfigure      = &dnszoneResource{}
+	_ resource.ResourceWithImportState    = &dnszoneResource{}
+	_ resource.ResourceWithModifyPlan     = &dnszoneResource{}
+	_ resource.ResourceWithValidateConfig = &dnszoneResource{}
 )
 
 // NewDNSzoneResource is a helper function to simplify the provider implementation.
@@ -42,6 +50,24 @@ type dnszoneModel struct {
 	ZoneRetry   types.Int64  `tfsdk:"zone_retry"`
 	ZoneSerial  types.Int64  `tfsdk:"zone_serial"`
 	ZoneTTL     types.Int64  `tfsdk:"zone_ttl"`
+
+	SerialPolicy types.String `tfsdk:"serial_policy"`
+
+	DNSSECEnabled     types.Bool   `tfsdk:"dnssec_enabled"`
+	DNSSECAlgorithm   types.String `tfsdk:"dnssec_algorithm"`
+	DNSSECKSKSize     types.Int64  `tfsdk:"dnssec_ksk_size"`
+	DNSSECZSKSize     types.Int64  `tfsdk:"dnssec_zsk_size"`
+	DNSSECKSKRollover types.Int64  `tfsdk:"dnssec_ksk_rollover_days"`
+	DNSSECZSKRollover types.Int64  `tfsdk:"dnssec_zsk_rollover_days"`
+	DSRecords         types.List   `tfsdk:"ds_records"`
+
+	View          types.String `tfsdk:"view"`
+	ZoneRole      types.String `tfsdk:"zone_role"`
+	AllowTransfer types.List   `tfsdk:"allow_transfer"`
+	AllowQuery    types.List   `tfsdk:"allow_query"`
+	AlsoNotify    types.List   `tfsdk:"also_notify"`
+	Masters       types.List   `tfsdk:"masters"`
+	Forwarders    types.List   `tfsdk:"forwarders"`
 }
 
 // dnszoneResource is the resource implementation.
@@ -79,7 +105,7 @@ func (r *dnszoneResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Required:    true,
 			},
 			"parent_id": schema.StringAttribute{
-				Description: "Parent ID for the Zone mainly because of permissions, if it is not set ProVision will set TLR by default.",
+				Description: "Parent ID for the Zone mainly because of permissions, the recursive provision6connect_resource_permission granted on the parent apply to it. If it is not set ProVision will set TLR by default.",
 				Optional:    true,
 				Computed:    true,
 			},
@@ -132,15 +158,111 @@ func (r *dnszoneResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Optional:    true,
 			},
 			"zone_serial": schema.Int64Attribute{
-				Description: "DNS Zone Serial",
+				Description: "DNS Zone Serial, it can not be set together with serial_policy",
 				Computed:    true,
 				Optional:    true,
 			},
+			"serial_policy": schema.StringAttribute{
+				Description: "Let the provider manage the zone serial, it can be date (YYYYMMDDnn), increment, unix or server. The serial is bumped whenever the zone or its records change and never goes backwards, server leaves the serial to ProVision. Record changes are detected on the next plan, records changed in the same apply as the zone bump the serial on the following apply.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringOneOf(zoneSerialPolicyDate, zoneSerialPolicyIncrement, zoneSerialPolicyUnix, zoneSerialPolicyServer),
+				},
+			},
 			"zone_ttl": schema.Int64Attribute{
 				Description: "DNS Zone TTL",
 				Computed:    true,
 				Optional:    true,
 			},
+			"dnssec_enabled": schema.BoolAttribute{
+				Description: "Sign the DNS Zone with DNSSEC, the dnssec_* settings can only be set when it is true.",
+				Optional:    true,
+			},
+			"dnssec_algorithm": schema.StringAttribute{
+				Description: "DNSSEC signing algorithm, it can be RSASHA256, RSASHA512, ECDSAP256SHA256, ECDSAP384SHA384 or ED25519. ProVision picks one when it is not set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringOneOf(dnssecAlgorithmRSASHA256, dnssecAlgorithmRSASHA512, dnssecAlgorithmECDSAP256SHA256, dnssecAlgorithmECDSAP384SHA384, dnssecAlgorithmED25519),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dnssec_ksk_size": schema.Int64Attribute{
+				Description: "Key Signing Key size in bits, only for RSA algorithms Ex: 2048",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dnssec_zsk_size": schema.Int64Attribute{
+				Description: "Zone Signing Key size in bits, only for RSA algorithms Ex: 1024",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dnssec_ksk_rollover_days": schema.Int64Attribute{
+				Description: "Days between Key Signing Key rollovers Ex: 365. A rollover changes ds_records, the registrar must be updated with them.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"dnssec_zsk_rollover_days": schema.Int64Attribute{
+				Description: "Days between Zone Signing Key rollovers Ex: 30",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ds_records": schema.ListAttribute{
+				Description: "DS records of the Key Signing Key to publish at the registrar in presentation format Ex: 12345 13 2 ABCDEF..., empty when DNSSEC is not enabled.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"view": schema.StringAttribute{
+				Description: "Name of the DNS view the zone is served in, for split-horizon DNS",
+				Optional:    true,
+			},
+			"zone_role": schema.StringAttribute{
+				Description: "Role of the zone on the DNS servers, it can be primary, secondary or forward, defaults to primary. Secondary zones require masters and forward zones require forwarders. " +
+					"It is not the same as zone_type which tells forward and reverse lookup zones apart.",
+				Optional: true,
+				Validators: []validator.String{
+					stringOneOf(zoneRolePrimary, zoneRoleSecondary, zoneRoleForward),
+				},
+			},
+			"allow_transfer": schema.ListAttribute{
+				Description: "Address match list of the clients allowed to transfer the zone Ex: [\"192.0.2.53\", \"key transfer-key\"]",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"allow_query": schema.ListAttribute{
+				Description: "Address match list of the clients allowed to query the zone Ex: [\"10.0.0.0/8\", \"localnets\"]",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"also_notify": schema.ListAttribute{
+				Description: "IP addresses of servers notified of zone changes in addition to the NS records",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"masters": schema.ListAttribute{
+				Description: "IP addresses of the primary servers a secondary zone is transferred from, only for secondary zones",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"forwarders": schema.ListAttribute{
+				Description: "IP addresses of the servers queries are forwarded to, only for forward zones",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -184,7 +306,9 @@ func (r *dnszoneResource) Create(ctx context.Context, req resource.CreateRequest
 	if !plan.ZoneRetry.IsNull() {
 		newZone.ZoneRetry = int(plan.ZoneRetry.ValueInt64())
 	}
-	if !plan.ZoneSerial.IsNull() {
+	if !plan.SerialPolicy.IsNull() {
+		newZone.ZoneSerial = int(nextZoneSerial(plan.SerialPolicy.ValueString(), 0, time.Now()))
+	} else if !plan.ZoneSerial.IsNull() {
 		newZone.ZoneSerial = int(plan.ZoneSerial.ValueInt64())
 	}
 	if !plan.ZoneTTL.IsNull() {
@@ -225,6 +349,181 @@ func (r *dnszoneResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	// DNSSEC and the zone options are configured once the zone exists, the zone is
+	// kept in state if they fail
+	resp.Diagnostics.Append(r.applyDNSSEC(ctx, &plan, false)...)
+	if zoneOptionsConfigured(plan) {
+		resp.Diagnostics.Append(r.applyZoneOptions(ctx, plan)...)
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SerialPolicy.IsNull() {
+		r.setRecordsFingerprint(ctx, plan.ID.ValueString(), resp.Private.SetKey, &resp.Diagnostics)
+	}
+}
+
+// ValidateConfig makes sure the serial is not configured when it is managed by
+// serial_policy, checks the DNSSEC settings and the settings required by zone_role.
+func (r *dnszoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dnszoneModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SerialPolicy.IsNull() && !config.ZoneSerial.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_serial"),
+			"Conflicting DNS Zone Serial Configuration",
+			"zone_serial can not be set when serial_policy is set, the serial is managed by the provider.",
+		)
+	}
+
+	resp.Diagnostics.Append(validateDNSSECConfig(config)...)
+	resp.Diagnostics.Append(validateZoneOptionsConfig(ctx, config)...)
+}
+
+// ModifyPlan keeps the DS records while the DNSSEC settings do not change and plans
+// a serial bump when the zone records changed since the last time the serial was
+// managed by serial_policy.
+func (r *dnszoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dnszoneModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !dnssecChanged(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ds_records"), state.DSRecords)...)
+	}
+
+	// The key sizes and rollovers ProVision picked for the previous algorithm may not
+	// apply to the new one, the ones not configured are read back after the change
+	if !plan.DNSSECAlgorithm.IsUnknown() && !plan.DNSSECAlgorithm.Equal(state.DNSSECAlgorithm) {
+		var config dnszoneModel
+		resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		keySettings := []struct {
+			name  string
+			value types.Int64
+		}{
+			{"dnssec_ksk_size", config.DNSSECKSKSize},
+			{"dnssec_zsk_size", config.DNSSECZSKSize},
+			{"dnssec_ksk_rollover_days", config.DNSSECKSKRollover},
+			{"dnssec_zsk_rollover_days", config.DNSSECZSKRollover},
+		}
+		for _, setting := range keySettings {
+			if setting.value.IsNull() {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(setting.name), types.Int64Unknown())...)
+			}
+		}
+	}
+
+	if r.client == nil {
+		return
+	}
+
+	if plan.SerialPolicy.IsNull() || plan.SerialPolicy.IsUnknown() || plan.SerialPolicy.ValueString() == zoneSerialPolicyServer {
+		return
+	}
+
+	previous, diags := req.Private.GetKey(ctx, zoneRecordsFingerprintKey)
+	resp.Diagnostics.Append(diags...)
+	if previous == nil {
+		return
+	}
+
+	current, err := zoneRecordsFingerprintState(&r.client.DNS, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read ProVision DNS Zone Records",
+			"The DNS Zone records could not be checked for changes, the zone serial will not be bumped for them: "+err.Error(),
+		)
+		return
+	}
+
+	if string(current) != string(previous) {
+		tflog.Info(ctx, "DNS Zone ID "+plan.ID.ValueString()+" records changed, planning a serial bump")
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("zone_serial"), types.Int64Unknown())...)
+	}
+}
+
+// setRecordsFingerprint stores the fingerprint of the zone records into the private state.
+func (r *dnszoneResource) setRecordsFingerprint(ctx context.Context, zoneID string, setKey func(context.Context, string, []byte) diag.Diagnostics, respDiags *diag.Diagnostics) {
+	fingerprint, err := zoneRecordsFingerprintState(&r.client.DNS, zoneID)
+	if err != nil {
+		respDiags.AddWarning(
+			"Unable to Read ProVision DNS Zone Records",
+			"The DNS Zone records could not be read, record changes will not bump the serial until the next update: "+err.Error(),
+		)
+		return
+	}
+
+	respDiags.Append(setKey(ctx, zoneRecordsFingerprintKey, fingerprint)...)
+}
+
+// applyDNSSEC sends the DNSSEC settings of the plan when DNSSEC is or was enabled and
+// maps the settings read back from ProVision, with the DS records, to the plan.
+func (r *dnszoneResource) applyDNSSEC(ctx context.Context, plan *dnszoneModel, wasEnabled bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if plan.DNSSECEnabled.ValueBool() || wasEnabled {
+		tflog.Info(ctx, "Updating DNSSEC settings of DNS Zone ID "+plan.ID.ValueString())
+		_, err := updateDNSZoneDNSSEC(&r.client.DNS, plan.ID.ValueString(), dnssecFromPlan(*plan))
+		if err != nil {
+			diags.AddError(
+				"Error Updating ProVision DNS Zone DNSSEC",
+				"Could not update the DNSSEC settings of ProVision DNS Zone ID "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+			)
+			diags.Append(dnssecToState(ctx, plan, nil)...)
+			return diags
+		}
+	}
+
+	dnssec, err := getDNSZoneDNSSEC(&r.client.DNS, plan.ID.ValueString())
+	if err != nil {
+		if plan.DNSSECEnabled.ValueBool() {
+			diags.AddError(
+				"Error Reading ProVision DNS Zone DNSSEC",
+				"Could not read the DNSSEC settings of ProVision DNS Zone ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+		}
+		// Zones without DNSSEC do not need the settings endpoint
+		tflog.Debug(ctx, "Could not read the DNSSEC settings of DNS Zone ID "+plan.ID.ValueString()+": "+err.Error())
+	}
+
+	diags.Append(dnssecToState(ctx, plan, dnssec)...)
+	return diags
+}
+
+// applyZoneOptions sends the view, transfer and query settings of the plan.
+func (r *dnszoneResource) applyZoneOptions(ctx context.Context, plan dnszoneModel) diag.Diagnostics {
+	options, diags := zoneOptionsFromPlan(ctx, plan)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Info(ctx, "Updating options of DNS Zone ID "+plan.ID.ValueString())
+	_, err := updateDNSZoneOptions(&r.client.DNS, plan.ID.ValueString(), options)
+	if err != nil {
+		diags.AddError(
+			"Error Updating ProVision DNS Zone Options",
+			"Could not update the view, transfer and query settings of ProVision DNS Zone ID "+plan.ID.ValueString()+", unexpected error: "+err.Error(),
+		)
+	}
+	return diags
 }
 
 func (r *dnszoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -267,20 +566,65 @@ func (r *dnszoneResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.ZoneMail = types.StringValue(dnszone.ZoneMail)
 	state.ZoneType = types.StringValue(dnszone.ZoneType)
 	state.ParentID = types.StringValue(string(dnszone.ParentID))
+	if dnszone.GroupID != "" {
+		state.GroupID = types.StringValue(string(dnszone.GroupID))
+	}
 	state.Status = types.StringValue(dnszone.Status)
 	state.ZoneExpire = types.Int64Value(int64(dnszone.ZoneExpire))
 	state.ZoneMinimum = types.Int64Value(int64(dnszone.ZoneMinimum))
 	state.ZoneRefresh = types.Int64Value(int64(dnszone.ZoneRefresh))
 	state.ZoneRetry = types.Int64Value(int64(dnszone.ZoneRetry))
-	state.ZoneSerial = types.Int64Value(int64(dnszone.ZoneSerial))
 	state.ZoneTTL = types.Int64Value(int64(dnszone.ZoneTTL))
 
+	// Serial increments done by ProVision are not drift when the serial is
+	// managed by serial_policy, Update always continues from the highest one.
+	if state.SerialPolicy.IsNull() || state.ZoneSerial.IsNull() || int64(dnszone.ZoneSerial) < state.ZoneSerial.ValueInt64() {
+		state.ZoneSerial = types.Int64Value(int64(dnszone.ZoneSerial))
+	}
+
+	// Get refreshed DNSSEC settings, they are only required once DNSSEC is enabled
+	dnssec, err := getDNSZoneDNSSEC(&r.client.DNS, state.ID.ValueString())
+	if err != nil && state.DNSSECEnabled.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Zone DNSSEC",
+			"Could not read the DNSSEC settings of ProVision DNS Zone ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if err == nil {
+		resp.Diagnostics.Append(dnssecToState(ctx, &state, dnssec)...)
+	} else if state.DSRecords.IsNull() {
+		state.DSRecords = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	// Get refreshed zone options, they are only required once some are configured
+	options, err := getDNSZoneOptions(&r.client.DNS, state.ID.ValueString())
+	if err != nil && zoneOptionsConfigured(state) {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Zone Options",
+			"Could not read the view, transfer and query settings of ProVision DNS Zone ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if err == nil {
+		resp.Diagnostics.Append(zoneOptionsToState(ctx, &state, options)...)
+	}
+
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Imported zones start tracking record changes from the first refresh.
+	if !state.SerialPolicy.IsNull() {
+		fingerprint, diags := req.Private.GetKey(ctx, zoneRecordsFingerprintKey)
+		resp.Diagnostics.Append(diags...)
+		if fingerprint == nil {
+			r.setRecordsFingerprint(ctx, state.ID.ValueString(), resp.Private.SetKey, &resp.Diagnostics)
+		}
+	}
 }
 
 func (r *dnszoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -293,6 +637,32 @@ func (r *dnszoneResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 	tflog.Info(ctx, "Updating DNS Zone ID "+plan.ID.ValueString())
 
+	var state dnszoneModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The serial is compared with the one on the server, it may have been
+	// bumped by ProVision or another tool since the last refresh.
+	zones, err := r.client.DNS.GetZoneByID(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Zone",
+			"Could not read the serial of ProVision DNS Zone ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if len(zones) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision DNS Zone",
+			"ProVision DNS Zone has not been found ID "+plan.ID.ValueString(),
+		)
+		return
+	}
+	serverSerial := int64(zones[0].ZoneSerial)
+
 	newZone := provisionclient.DNSZone{
 		ID:          provisionclient.PVID(plan.ID.ValueString()),
 		Name:        plan.Name.ValueString(),
@@ -304,10 +674,27 @@ func (r *dnszoneResource) Update(ctx context.Context, req resource.UpdateRequest
 		ZoneMinimum: int(plan.ZoneMinimum.ValueInt64()),
 		ZoneRefresh: int(plan.ZoneRefresh.ValueInt64()),
 		ZoneRetry:   int(plan.ZoneRetry.ValueInt64()),
-		ZoneSerial:  int(plan.ZoneSerial.ValueInt64()),
 		ZoneTTL:     int(plan.ZoneTTL.ValueInt64()),
 	}
 
+	if !plan.SerialPolicy.IsNull() {
+		current := state.ZoneSerial.ValueInt64()
+		if serverSerial > current {
+			current = serverSerial
+		}
+		newZone.ZoneSerial = int(nextZoneSerial(plan.SerialPolicy.ValueString(), current, time.Now()))
+	} else if !plan.ZoneSerial.IsUnknown() {
+		newZone.ZoneSerial = int(plan.ZoneSerial.ValueInt64())
+		if plan.ZoneSerial.ValueInt64() < serverSerial {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("zone_serial"),
+				"DNS Zone Serial Goes Backwards",
+				"The configured zone_serial "+strconv.FormatInt(plan.ZoneSerial.ValueInt64(), 10)+" is lower than the current serial "+strconv.FormatInt(serverSerial, 10)+
+					", secondary servers may not pick up the change. Consider using serial_policy.",
+			)
+		}
+	}
+
 	// Update existing order
 	dnszone, err := r.client.DNS.UpdateZone(newZone)
 	if err != nil {
@@ -332,11 +719,27 @@ func (r *dnszoneResource) Update(ctx context.Context, req resource.UpdateRequest
 	plan.ZoneTTL = types.Int64Value(int64(dnszone.ZoneTTL))
 	plan.Modified = types.StringValue(plan.Modified.ValueString())
 
+	// Update DNSSEC settings
+	if dnssecChanged(plan, state) {
+		resp.Diagnostics.Append(r.applyDNSSEC(ctx, &plan, state.DNSSECEnabled.ValueBool())...)
+	} else {
+		plan.DSRecords = state.DSRecords
+	}
+
+	// Update zone options
+	if zoneOptionsChanged(plan, state) {
+		resp.Diagnostics.Append(r.applyZoneOptions(ctx, plan)...)
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if !plan.SerialPolicy.IsNull() {
+		r.setRecordsFingerprint(ctx, plan.ID.ValueString(), resp.Private.SetKey, &resp.Diagnostics)
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
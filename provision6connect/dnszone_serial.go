@@ -0,0 +1,79 @@
+package provision6connect
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// Zone serial policies accepted by the dnszone resource.
+const (
+	zoneSerialPolicyDate      = "date"
+	zoneSerialPolicyIncrement = "increment"
+	zoneSerialPolicyUnix      = "unix"
+	zoneSerialPolicyServer    = "server"
+)
+
+// zoneRecordsFingerprintKey is the private state key holding the fingerprint of
+// the zone records the serial was last bumped for.
+const zoneRecordsFingerprintKey = "records_fingerprint"
+
+// nextZoneSerial returns the serial that follows current for the given policy.
+// The result is always greater than current so secondaries never see the SOA
+// going backwards. The server policy leaves the serial to ProVision and returns 0.
+func nextZoneSerial(policy string, current int64, now time.Time) int64 {
+	switch policy {
+	case zoneSerialPolicyDate:
+		today, _ := strconv.ParseInt(now.UTC().Format("20060102"), 10, 64)
+		if current < today*100 {
+			return today * 100
+		}
+		return current + 1
+	case zoneSerialPolicyIncrement:
+		return current + 1
+	case zoneSerialPolicyUnix:
+		if current < now.Unix() {
+			return now.Unix()
+		}
+		return current + 1
+	}
+	return 0
+}
+
+// zoneRecordsFingerprint hashes the zone records, ignoring the SOA which
+// changes every time the serial is bumped.
+func zoneRecordsFingerprint(records []provisionclient.DNSRecord) string {
+	lines := make([]string, 0, len(records))
+	for _, record := range records {
+		if strings.EqualFold(record.RecordType, "SOA") {
+			continue
+		}
+		lines = append(lines, strings.Join([]string{
+			strings.ToUpper(record.RecordType),
+			canonicalFQDN(record.RecordHost),
+			record.RecordValue,
+			strconv.Itoa(record.RecordTTL),
+		}, "|"))
+	}
+	sort.Strings(lines)
+
+	sum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// zoneRecordsFingerprintState returns the fingerprint of the zone records encoded
+// for the private state.
+func zoneRecordsFingerprintState(dns *provisionclient.DNSMethods, zoneID string) ([]byte, error) {
+	records, err := dns.GetZoneRecords(zoneID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(zoneRecordsFingerprint(records))
+}
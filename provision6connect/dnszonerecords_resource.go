@@ -0,0 +1,447 @@
+package provision6connect
+
+import (
+	"context"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnszonerecordsResource{}
+	_ resource.ResourceWithConfigure      = &dnszonerecordsResource{}
+	_ resource.ResourceWithImportState    = &dnszonerecordsResource{}
+	_ resource.ResourceWithModifyPlan     = &dnszonerecordsResource{}
+	_ resource.ResourceWithValidateConfig = &dnszonerecordsResource{}
+)
+
+// NewDNSzonerecordsResource is a helper function to simplify the provider implementation.
+func NewDNSzonerecordsResource() resource.Resource {
+	return &dnszonerecordsResource{}
+}
+
+// dnszonerecordsModel maps DNS zone records schema data.
+type dnszonerecordsModel struct {
+	ID                types.String `tfsdk:"id"`
+	ZoneID            types.String `tfsdk:"zone_id"`
+	IgnoreApexRecords types.Bool   `tfsdk:"ignore_apex_records"`
+	Records           types.Set    `tfsdk:"records"`
+}
+
+// dnszonerecordsRecordModel maps a single record of the zone.
+type dnszonerecordsRecordModel struct {
+	Name        types.String `tfsdk:"name"`
+	RecordType  types.String `tfsdk:"record_type"`
+	RecordHost  types.String `tfsdk:"record_host"`
+	RecordValue types.String `tfsdk:"record_value"`
+	RecordTTL   types.Int64  `tfsdk:"record_ttl"`
+}
+
+var dnszonerecordsRecordType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":         types.StringType,
+		"record_type":  types.StringType,
+		"record_host":  types.StringType,
+		"record_value": types.StringType,
+		"record_ttl":   types.Int64Type,
+	},
+}
+
+// dnszonerecordsResource is the resource implementation.
+type dnszonerecordsResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnszonerecordsResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *dnszonerecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnszone_records"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnszonerecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "DNS Zone Records Resource that owns every DNS Record of a ProVision DNS Zone. Records added outside of Terraform show up as a diff and are deleted on the next apply, " +
+			"so it must not be used together with provision6connect_dnsrecord or provision6connect_dns_recordset on the same zone. It can be imported with the zone ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone whose records are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ignore_apex_records": schema.BoolAttribute{
+				Description: "Leave the SOA and the NS records of the zone apex out of the managed records, defaults to true. When it is false they must be listed in records as well, the SOA is never deleted.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"records": schema.SetNestedAttribute{
+				Description: "Complete list of the DNS Records of the zone",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Pretty name describing the DNS Record, record_host is used if it is not set.",
+							Optional:    true,
+						},
+						"record_type": schema.StringAttribute{
+							Description: "DNS Record Type Ex: A, AAAA, TXT, PTR, NS",
+							Required:    true,
+						},
+						"record_host": schema.StringAttribute{
+							Description: "FQDN of the DNS Record ending with a dot and inside the DNS Zone Ex: test.example.com.",
+							Required:    true,
+						},
+						"record_value": schema.StringAttribute{
+							Description: "DNS Record Value Ex: 192.168.0.1",
+							Required:    true,
+						},
+						"record_ttl": schema.Int64Attribute{
+							Description: "DNS Record TTL Ex: 900",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig validates the host and value of every record.
+func (r *dnszonerecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config dnszonerecordsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Records.IsUnknown() {
+		return
+	}
+
+	var records []dnszonerecordsRecordModel
+	resp.Diagnostics.Append(config.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, record := range records {
+		if !record.RecordHost.IsUnknown() {
+			if err := validateDNSName(record.RecordHost.ValueString(), true, true); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("records"),
+					"Invalid DNS Record Host",
+					"record_host "+err.Error(),
+				)
+			}
+		}
+
+		if record.RecordType.IsUnknown() || record.RecordValue.IsUnknown() || !knownDNSRecordType(record.RecordType.ValueString()) {
+			continue
+		}
+		if _, err := normalizeDNSRecordValue(record.RecordType.ValueString(), record.RecordValue.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("records"),
+				"Invalid DNS Record Value",
+				record.RecordHost.ValueString()+" "+err.Error(),
+			)
+		}
+	}
+}
+
+// ModifyPlan defaults ignore_apex_records to true and makes sure every record is inside the zone.
+func (r *dnszonerecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dnszonerecordsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IgnoreApexRecords.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ignore_apex_records"), types.BoolValue(true))...)
+	}
+
+	if r.client == nil || plan.ZoneID.IsUnknown() || plan.Records.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state dnszonerecordsModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.Records.Equal(plan.Records) {
+			return
+		}
+	}
+
+	zones, err := r.client.DNS.GetZoneByID(plan.ZoneID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read ProVision DNS Zone",
+			"The DNS Record hosts could not be checked against DNS Zone ID "+plan.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(zones) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_id"),
+			"Error Finding ProVision DNS Zone",
+			"ProVision DNS Zone has not been found ID "+plan.ZoneID.ValueString(),
+		)
+		return
+	}
+
+	var records []dnszonerecordsRecordModel
+	resp.Diagnostics.Append(plan.Records.ElementsAs(ctx, &records, false)...)
+	for _, record := range records {
+		if !record.RecordHost.IsUnknown() && !dnsNameInZone(record.RecordHost.ValueString(), zones[0].Name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("records"),
+				"DNS Record Outside of DNS Zone",
+				"record_host "+record.RecordHost.ValueString()+" is not inside the DNS Zone "+zones[0].Name+".",
+			)
+		}
+	}
+}
+
+// converge adds, updates and deletes the records of the zone to match the plan.
+func (r *dnszonerecordsResource) converge(ctx context.Context, plan dnszonerecordsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var records []dnszonerecordsRecordModel
+	diags.Append(plan.Records.ElementsAs(ctx, &records, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, current, err := zoneManagedRecords(&r.client.DNS, plan.ZoneID.ValueString(), plan.IgnoreApexRecords.ValueBool())
+	if err != nil {
+		diags.AddError(
+			"Error Reading ProVision DNS Records",
+			"Could not read the ProVision DNS Records of DNS Zone ID "+plan.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	desired := make([]provisionclient.DNSRecord, 0, len(records))
+	for _, record := range records {
+		name := record.Name.ValueString()
+		if record.Name.IsNull() {
+			name = record.RecordHost.ValueString()
+		}
+		desired = append(desired, provisionclient.DNSRecord{
+			Name:        name,
+			ParentID:    provisionclient.PVID(plan.ZoneID.ValueString()),
+			RecordType:  record.RecordType.ValueString(),
+			RecordHost:  record.RecordHost.ValueString(),
+			RecordValue: record.RecordValue.ValueString(),
+			RecordTTL:   int(record.RecordTTL.ValueInt64()),
+		})
+	}
+
+	if _, err := syncDNSRecords(&r.client.DNS, current, desired); err != nil {
+		diags.AddError(
+			"Error Updating ProVision DNS Zone Records",
+			"Could not update the ProVision DNS Records of DNS Zone ID "+plan.ZoneID.ValueString()+", unexpected error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// Create a new resource
+func (r *dnszonerecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnszonerecordsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Take over the zone records
+	resp.Diagnostics.Append(r.converge(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(plan.ZoneID.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnszonerecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and zone_id attributes
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), req.ID)...)
+}
+
+// Read resource information
+func (r *dnszonerecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnszonerecordsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.IgnoreApexRecords.IsNull() {
+		state.IgnoreApexRecords = types.BoolValue(true)
+	}
+
+	_, records, err := zoneManagedRecords(&r.client.DNS, state.ZoneID.ValueString(), state.IgnoreApexRecords.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision DNS Zone Records",
+			"Could not read the ProVision DNS Records of DNS Zone ID "+state.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	var current []dnszonerecordsRecordModel
+	if !state.Records.IsNull() {
+		resp.Diagnostics.Append(state.Records.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Records that only differ in their format from the ones in state are kept,
+	// the rest show up as they are in ProVision
+	refreshed := make([]dnszonerecordsRecordModel, 0, len(records))
+	for _, record := range records {
+		model := dnszonerecordsRecordModel{
+			Name:        types.StringNull(),
+			RecordType:  types.StringValue(record.RecordType),
+			RecordHost:  types.StringValue(record.RecordHost),
+			RecordValue: types.StringValue(record.RecordValue),
+		}
+		for _, existing := range current {
+			if sameDNSRecord(record, provisionclient.DNSRecord{
+				RecordType:  existing.RecordType.ValueString(),
+				RecordHost:  existing.RecordHost.ValueString(),
+				RecordValue: existing.RecordValue.ValueString(),
+			}) {
+				model = existing
+				if !existing.Name.IsNull() {
+					model.Name = types.StringValue(record.Name)
+				}
+				break
+			}
+		}
+		model.RecordTTL = types.Int64Value(int64(record.RecordTTL))
+		refreshed = append(refreshed, model)
+	}
+
+	state.Records, diags = types.SetValueFrom(ctx, dnszonerecordsRecordType, refreshed)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnszonerecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnszonerecordsModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating DNS Zone Records of DNS Zone ID "+plan.ZoneID.ValueString())
+
+	// Update existing records
+	resp.Diagnostics.Append(r.converge(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnszonerecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnszonerecordsModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var declared []dnszonerecordsRecordModel
+	resp.Diagnostics.Append(state.Records.ElementsAs(ctx, &declared, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, records, err := zoneManagedRecords(&r.client.DNS, state.ZoneID.ValueString(), state.IgnoreApexRecords.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision DNS Zone Records",
+			"Could not read the ProVision DNS Records of DNS Zone ID "+state.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Delete the records in state, the zone itself is left to provision6connect_dnszone
+	desired := make([]provisionclient.DNSRecord, 0, len(declared))
+	for _, existing := range declared {
+		desired = append(desired, provisionclient.DNSRecord{
+			RecordType:  existing.RecordType.ValueString(),
+			RecordHost:  existing.RecordHost.ValueString(),
+			RecordValue: existing.RecordValue.ValueString(),
+		})
+	}
+
+	err = deleteDeclaredDNSRecords(&r.client.DNS, state.ZoneID.ValueString(), records, desired)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision DNS Zone Records",
+			"Could not delete the ProVision DNS Records of DNS Zone ID "+state.ZoneID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -2,17 +2,20 @@ package provision6connect
 
 import (
 	"context"
+	"strconv"
 
 	provisionclient "github.com/6connect/golangclient"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // netblocksDataSourceModel maps the data source schema data.
 type netblocksDataSourceModel struct {
-	Search    map[string]string `tfsdk:"search"`
-	Netblocks []netblocksModel  `tfsdk:"netblocks"`
+	Search     map[string]string `tfsdk:"search"`
+	MaxResults types.Int64       `tfsdk:"max_results"`
+	Netblocks  []netblocksModel  `tfsdk:"netblocks"`
 }
 
 // netblocksModel maps netblocks schema data.
@@ -116,6 +119,10 @@ func (d *netblocksDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				MarkdownDescription: "The map will be used into the API request to retrieve netblock data",
 				Optional:            true,
 			},
+			"max_results": schema.Int64Attribute{
+				Description: "Maximum number of NetBlocks read when search has no limit and every page is read, defaults to 10000. A warning is reported when more NetBlocks match",
+				Optional:    true,
+			},
 			"netblocks": schema.ListNestedAttribute{
 				Description: "Contains a list of the NetBlocks found by the search query",
 				Computed:    true,
@@ -316,7 +323,34 @@ func (d *netblocksDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	netblocks, err := d.client.IPAM.GetNetblocks(&state.Search)
+	maxResults := listDefaultMaxResults
+	if !state.MaxResults.IsNull() {
+		if state.MaxResults.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_results"),
+				"Invalid Netblocks Pagination",
+				"max_results must be at least 1.",
+			)
+			return
+		}
+		maxResults = int(state.MaxResults.ValueInt64())
+	}
+
+	// A limit in search reads that page only, every page is read otherwise
+	var netblocks []provisionclient.Netblock
+	var truncated bool
+	var err error
+	if _, ok := state.Search["limit"]; ok {
+		netblocks, err = d.client.IPAM.GetNetblocks(&state.Search)
+	} else {
+		offset, _ := strconv.Atoi(state.Search["offset"])
+		netblocks, truncated, err = fetchAllPages(func(offset, limit int) ([]provisionclient.Netblock, error) {
+			page := pageFilters(state.Search, offset, limit)
+			return d.client.IPAM.GetNetblocks(&page)
+		}, func(netblock provisionclient.Netblock) string {
+			return string(netblock.ID)
+		}, offset, maxResults)
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -326,6 +360,13 @@ func (d *netblocksDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"ProVision Netblocks Truncated",
+			"Only the first "+strconv.Itoa(maxResults)+" NetBlocks have been read, more match the search. Narrow the search or raise max_results.",
+		)
+	}
+
 	// Map response body to model
 	for _, netblock := range netblocks {
 		/*
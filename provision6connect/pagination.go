@@ -0,0 +1,106 @@
+package provision6connect
+
+import (
+	"strconv"
+	"sync"
+)
+
+const (
+	// listPageSize is the number of items requested per page by the list data sources.
+	listPageSize = 500
+
+	// listPageConcurrency is the number of pages requested at the same time.
+	listPageConcurrency = 4
+
+	// listDefaultMaxResults caps the number of items a list data source loads when
+	// max_results is not set.
+	listDefaultMaxResults = 10000
+)
+
+// pageFilters returns a copy of the filters with the limit and offset of a page.
+func pageFilters(filters map[string]string, offset, limit int) map[string]string {
+	page := make(map[string]string, len(filters)+2)
+	for key, value := range filters {
+		page[key] = value
+	}
+	page["offset"] = strconv.Itoa(offset)
+	page["limit"] = strconv.Itoa(limit)
+	return page
+}
+
+// fetchAllPages reads every page of a list endpoint, starting at offset. The first
+// page is read alone so small lists cost few requests, its length is taken as the
+// page size as the server may return fewer items than the limit asked for. The next
+// pages are read listPageConcurrency at a time and the offset moves by the number of
+// items each page returned. Items seen on a previous page are skipped, and reading
+// stops on an empty page or a page without new items, in case the endpoint ignores
+// the offset. At most maxResults items are returned, truncated reports whether more
+// items were left.
+func fetchAllPages[T any](fetch func(offset, limit int) ([]T, error), id func(T) string, offset, maxResults int) (items []T, truncated bool, err error) {
+	items = []T{}
+	seen := map[string]bool{}
+
+	pageSize := 0
+	batch := 1
+	for {
+		// No page is read past the one holding the item after the cap
+		if pageSize != 0 {
+			if needed := (maxResults-len(items))/pageSize + 1; batch > needed {
+				batch = needed
+			}
+		}
+
+		pages := make([][]T, batch)
+		errs := make([]error, batch)
+
+		var wg sync.WaitGroup
+		for i := 0; i < batch; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				pages[i], errs[i] = fetch(offset+i*pageSize, listPageSize)
+			}(i)
+		}
+		wg.Wait()
+
+		next := listPageConcurrency
+		for i, page := range pages {
+			if errs[i] != nil {
+				return nil, false, errs[i]
+			}
+
+			added := 0
+			for _, item := range page {
+				key := id(item)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+
+				if len(items) == maxResults {
+					return items, true, nil
+				}
+				items = append(items, item)
+				added++
+			}
+
+			if len(page) == 0 || added == 0 {
+				return items, false, nil
+			}
+
+			offset += len(page)
+			if pageSize == 0 {
+				pageSize = len(page)
+			}
+
+			// A short page is likely the last one, the next pages of the batch were
+			// read at the wrong offset anyway
+			if len(page) < pageSize {
+				next = 1
+				break
+			}
+		}
+
+		batch = next
+	}
+}
@@ -0,0 +1,147 @@
+package provision6connect
+
+import (
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// Access levels a group can be granted on a resource.
+const (
+	permissionAccessView  = "view"
+	permissionAccessEdit  = "edit"
+	permissionAccessAdmin = "admin"
+)
+
+// pvGroup is a user group as returned by the ProVision groups API.
+type pvGroup struct {
+	ID          provisionclient.PVID `json:"id,omitempty"`
+	Name        string               `json:"name"`
+	Description string               `json:"description"`
+	Modified    string               `json:"modified,omitempty"`
+}
+
+// pvUser is a user as returned by the ProVision users API. The password is never
+// returned, it is only sent when it is set.
+type pvUser struct {
+	ID        provisionclient.PVID    `json:"id,omitempty"`
+	Username  string                  `json:"username"`
+	Email     string                  `json:"email"`
+	FirstName string                  `json:"first_name"`
+	LastName  string                  `json:"last_name"`
+	Password  string                  `json:"password,omitempty"`
+	Enabled   *bool                   `json:"enabled,omitempty"`
+	GroupIDs  *[]provisionclient.PVID `json:"group_ids,omitempty"`
+	Modified  string                  `json:"modified,omitempty"`
+}
+
+// resourcePermission is the access granted to a group on a resource as returned by
+// the ProVision permissions API. Recursive permissions apply to the descendants too.
+type resourcePermission struct {
+	ID         provisionclient.PVID `json:"id,omitempty"`
+	ResourceID provisionclient.PVID `json:"resource_id"`
+	GroupID    provisionclient.PVID `json:"group_id"`
+	Access     string               `json:"access"`
+	Recursive  *bool                `json:"recursive,omitempty"`
+	Modified   string               `json:"modified,omitempty"`
+}
+
+func getGroupByID(client *provisionclient.Client, id string) ([]pvGroup, error) {
+	groups := []pvGroup{}
+	err := apiRequest(client, "GET", "/groups", &map[string]string{"id": id}, nil, &groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func addGroup(client *provisionclient.Client, group pvGroup) (*pvGroup, error) {
+	var resp_group pvGroup
+	err := apiRequest(client, "POST", "/groups", nil, group, &resp_group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_group, nil
+}
+
+func updateGroup(client *provisionclient.Client, group pvGroup) (*pvGroup, error) {
+	var resp_group pvGroup
+	err := apiRequest(client, "PATCH", "/groups/"+string(group.ID), nil, group, &resp_group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_group, nil
+}
+
+func deleteGroupByID(client *provisionclient.Client, id string) error {
+	return apiRequest(client, "DELETE", "/groups/"+id, nil, nil, nil)
+}
+
+func getUserByID(client *provisionclient.Client, id string) ([]pvUser, error) {
+	users := []pvUser{}
+	err := apiRequest(client, "GET", "/users", &map[string]string{"id": id}, nil, &users)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func addUser(client *provisionclient.Client, user pvUser) (*pvUser, error) {
+	var resp_user pvUser
+	err := apiRequest(client, "POST", "/users", nil, user, &resp_user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_user, nil
+}
+
+func updateUser(client *provisionclient.Client, user pvUser) (*pvUser, error) {
+	var resp_user pvUser
+	err := apiRequest(client, "PATCH", "/users/"+string(user.ID), nil, user, &resp_user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_user, nil
+}
+
+func deleteUserByID(client *provisionclient.Client, id string) error {
+	return apiRequest(client, "DELETE", "/users/"+id, nil, nil, nil)
+}
+
+func getResourcePermissionByID(client *provisionclient.Client, id string) ([]resourcePermission, error) {
+	permissions := []resourcePermission{}
+	err := apiRequest(client, "GET", "/permissions", &map[string]string{"id": id}, nil, &permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func addResourcePermission(client *provisionclient.Client, permission resourcePermission) (*resourcePermission, error) {
+	var resp_permission resourcePermission
+	err := apiRequest(client, "POST", "/permissions", nil, permission, &resp_permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_permission, nil
+}
+
+func updateResourcePermission(client *provisionclient.Client, permission resourcePermission) (*resourcePermission, error) {
+	var resp_permission resourcePermission
+	err := apiRequest(client, "PATCH", "/permissions/"+string(permission.ID), nil, permission, &resp_permission)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp_permission, nil
+}
+
+func deleteResourcePermissionByID(client *provisionclient.Client, id string) error {
+	return apiRequest(client, "DELETE", "/permissions/"+id, nil, nil, nil)
+}
@@ -187,6 +187,13 @@ func (p *provision6connectProvider) DataSources(_ context.Context) []func() data
 		NewDNSpushstatusDataSource,
 		NewDHCPpushDataSource,
 		NewDHCPpushstatusDataSource,
+		NewDHCPleasesDataSource,
+		NewDHCPpoolutilizationDataSource,
+		NewDNSzoneDataSource,
+		NewDNSrecordsDataSource,
+		NewZonefileDataSource,
+		NewResourcetreeDataSource,
+		NewPVresourceDataSource,
 	}
 }
 
@@ -199,5 +206,17 @@ func (p *provision6connectProvider) Resources(_ context.Context) []func() resour
 		NewIPAMnetblockResource,
 		NewDNSrecordResource,
 		NewDNSzoneResource,
+		NewDHCPoptionResource,
+		NewDNSserverResource,
+		NewDNSgroupResource,
+		NewReversezoneResource,
+		NewDNSrecordsetResource,
+		NewDNSzonerecordsResource,
+		NewZonefileResource,
+		NewResourceattributesResource,
+		NewGroupResource,
+		NewUserResource,
+		NewResourcepermissionResource,
+		NewResourcebatchResource,
 	}
 }
@@ -0,0 +1,73 @@
+package provision6connect
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// apiRequest calls a ProVision API v2 endpoint that is not wrapped by the
+// golangclient yet. It reuses the host, credentials and HTTP client of the
+// provider configured client, so requests behave the same as the client ones.
+// When out is not nil the response body is decoded into it.
+func apiRequest(client *provisionclient.Client, method, relativeURL string, filters *map[string]string, payload interface{}, out interface{}) error {
+	var fquery string
+	if filters != nil && len(*filters) != 0 {
+		values := url.Values{}
+		for key, value := range *filters {
+			values.Set(key, value)
+		}
+
+		fquery = "?" + values.Encode()
+	}
+
+	var inputBody io.Reader
+	if payload != nil {
+		reqbody, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		inputBody = bytes.NewBuffer(reqbody)
+	}
+
+	req, err := http.NewRequest(method, client.HostURL+"/api/v2/"+strings.Trim(relativeURL, "/")+fquery, inputBody)
+	if err != nil {
+		return err
+	}
+
+	auth := client.Auth.Username + ":" + client.Auth.Password
+	req.Header.Add("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(auth)))
+	req.Header.Add("Accept", "application/json")
+	if method != "GET" {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	res, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode > 299 {
+		return fmt.Errorf("status: %d, body: %s", res.StatusCode, body)
+	}
+
+	if out == nil || len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(body, out)
+}
@@ -0,0 +1,286 @@
+package provision6connect
+
+import (
+	"context"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pvresourceDataSourceModel maps the data source schema data.
+type pvresourceDataSourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Slug     types.String            `tfsdk:"slug"`
+	Name     types.String            `tfsdk:"name"`
+	Type     types.String            `tfsdk:"type"`
+	ParentID types.String            `tfsdk:"parent_id"`
+	Date     types.String            `tfsdk:"date"`
+	Modified types.String            `tfsdk:"modified"`
+	Attrs    map[string]string       `tfsdk:"attrs"`
+	Parents  []pvresourceParentModel `tfsdk:"parents"`
+}
+
+// pvresourceParentModel maps an ancestor of the resource.
+type pvresourceParentModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Slug types.String `tfsdk:"slug"`
+	Type types.String `tfsdk:"type"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &pvresourceDataSource{}
+	_ datasource.DataSourceWithConfigure = &pvresourceDataSource{}
+)
+
+// NewPVresourceDataSource is a helper function to simplify the provider implementation.
+func NewPVresourceDataSource() datasource.DataSource {
+	return &pvresourceDataSource{}
+}
+
+// pvresourceDataSource is the data source implementation.
+type pvresourceDataSource struct {
+	client *provisionclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *pvresourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pvresourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *pvresourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource Data Source looking up exactly one ProVision Resource by id, by slug, or by name and type. " +
+			"The lookup fails when no Resource or several Resources match.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the Resource to look up",
+				Optional:    true,
+				Computed:    true,
+			},
+			"slug": schema.StringAttribute{
+				Description: "Slug of the Resource to look up",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Resource to look up, type is required with it",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the Resource to look up with name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Parent Resource identifier Number, limits a lookup by name to the children of this Resource",
+				Optional:    true,
+				Computed:    true,
+			},
+			"date": schema.StringAttribute{
+				Description: "Date and Time of the creation",
+				Computed:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "Date and Time of the last modification",
+				Computed:    true,
+			},
+			"attrs": schema.MapAttribute{
+				Description: "Resource Attributes List",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"parents": schema.ListNestedAttribute{
+				Description: "Ancestors of the Resource, the parent first and the top level Resource last",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the Resource",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Resource Name",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "Resource Slug",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Resource Type",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pvresourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pvresourceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookups := 0
+	for _, set := range []bool{!state.ID.IsNull(), !state.Slug.IsNull(), !state.Name.IsNull()} {
+		if set {
+			lookups++
+		}
+	}
+	if lookups != 1 {
+		resp.Diagnostics.AddError(
+			"Exactly one of id, slug or name is required",
+			"Set exactly one of id, slug or name plus type to look up the ProVision Resource.",
+		)
+		return
+	}
+	if !state.Name.IsNull() && state.Type.IsNull() {
+		resp.Diagnostics.AddError(
+			"type is required with name",
+			"Resource names are only unique per type, set type to look up the ProVision Resource by name.",
+		)
+		return
+	}
+
+	var found []resourceRecord
+	var err error
+	var lookup string
+
+	if !state.ID.IsNull() {
+		lookup = "id " + state.ID.ValueString()
+		found, err = getResourceRecords(d.client, map[string]string{
+			"id":              state.ID.ValueString(),
+			"load_attributes": "1",
+		})
+	} else if !state.Slug.IsNull() {
+		lookup = "slug " + state.Slug.ValueString()
+		found, err = getResourceRecords(d.client, map[string]string{
+			"slug":            state.Slug.ValueString(),
+			"load_attributes": "1",
+		})
+	} else {
+		lookup = "name " + state.Name.ValueString() + " and type " + state.Type.ValueString()
+		filters := map[string]string{
+			"name":            state.Name.ValueString(),
+			"type":            state.Type.ValueString(),
+			"load_attributes": "1",
+		}
+		if !state.ParentID.IsNull() {
+			lookup += " under parent " + state.ParentID.ValueString()
+			filters["parent_id"] = state.ParentID.ValueString()
+		}
+		found, err = getResourceRecords(d.client, filters)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision Resource",
+			err.Error(),
+		)
+		return
+	}
+
+	// The API matches loosely, only exact matches of the configured values are kept.
+	// Resources without a type are left to the type filter of the API.
+	matches := []resourceRecord{}
+	for _, resource := range found {
+		if !state.ID.IsNull() && string(resource.ID) != state.ID.ValueString() {
+			continue
+		}
+		if !state.Slug.IsNull() && resource.Slug != state.Slug.ValueString() {
+			continue
+		}
+		if !state.Name.IsNull() && resource.Name != state.Name.ValueString() {
+			continue
+		}
+		if !state.Type.IsNull() && resource.Type != "" && resource.Type != state.Type.ValueString() {
+			continue
+		}
+		if !state.ParentID.IsNull() && string(resource.ParentID) != state.ParentID.ValueString() {
+			continue
+		}
+		matches = append(matches, resource)
+	}
+
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision Resource",
+			"No ProVision Resource has been found with "+lookup,
+		)
+		return
+	}
+
+	if len(matches) > 1 {
+		ids := make([]string, 0, len(matches))
+		for _, resource := range matches {
+			ids = append(ids, string(resource.ID))
+		}
+		resp.Diagnostics.AddError(
+			"Multiple ProVision Resources Found",
+			"Several ProVision Resources match "+lookup+" ("+strings.Join(ids, ", ")+"), set parent_id or look up by id or slug to select one of them.",
+		)
+		return
+	}
+
+	pvresource := matches[0].toResource()
+
+	parents, err := resourceParentChain(&d.client.Resources, pvresource)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision Resource Parents",
+			"Could not read the parents of ProVision Resource ID "+string(pvresource.ID)+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(string(pvresource.ID))
+	state.Slug = types.StringValue(pvresource.Slug)
+	state.Name = types.StringValue(pvresource.Name)
+	// The configured type is kept when the API does not return one
+	if pvresource.Type != "" || state.Type.IsNull() {
+		state.Type = types.StringValue(pvresource.Type)
+	}
+	state.ParentID = types.StringValue(string(pvresource.ParentID))
+	state.Date = types.StringValue(pvresource.Date)
+	state.Modified = types.StringValue(pvresource.Modified)
+	state.Attrs = pvresource.Attrs
+
+	state.Parents = []pvresourceParentModel{}
+	for _, parent := range parents {
+		state.Parents = append(state.Parents, pvresourceParentModel{
+			ID:   types.StringValue(string(parent.ID)),
+			Name: types.StringValue(parent.Name),
+			Slug: types.StringValue(parent.Slug),
+			Type: types.StringValue(parent.Type),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
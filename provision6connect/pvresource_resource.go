@@ -2,6 +2,7 @@ package provision6connect
 
 import (
 	"context"
+	"strings"
 
 	provisionclient "github.com/6connect/golangclient"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +20,7 @@ var (
 	_ resource.Resource                = &pvresourceResource{}
 	_ resource.ResourceWithConfigure   = &pvresourceResource{}
 	_ resource.ResourceWithImportState = &pvresourceResource{}
+	_ resource.ResourceWithModifyPlan  = &pvresourceResource{}
 )
 
 // NewPVresourceResource is a helper function to simplify the provider implementation.
@@ -34,6 +37,8 @@ type pvresourceModel struct {
 	Type     types.String      `tfsdk:"type"`
 	Modified types.String      `tfsdk:"modified"`
 	Attrs    map[string]string `tfsdk:"attrs"`
+
+	AllowReparent types.Bool `tfsdk:"allow_reparent"`
 }
 
 // pvresourceResource is the resource implementation.
@@ -58,7 +63,9 @@ func (r *pvresourceResource) Metadata(_ context.Context, req resource.MetadataRe
 // Schema defines the schema for the resource.
 func (r *pvresourceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "ProVision internal Resource preresentation into Terraform",
+		Description: "ProVision internal Resource preresentation into Terraform. It can be imported with the numeric ID or with slug:<slug>. " +
+			"Changing, setting or removing parent_id moves the Resource with its descendants and netblocks and requires allow_reparent. " +
+			"Renaming the Terraform address of a Resource only needs a moved block, the ProVision Resource is left untouched.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Numeric identifier of the Resource",
@@ -75,9 +82,20 @@ func (r *pvresourceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Parent Resource identifier Number",
 				Optional:    true,
 			},
+			"allow_reparent": schema.BoolAttribute{
+				Description: "Allow changing, setting or removing parent_id of the existing Resource, defaults to false. The new parent must exist and must not be a descendant of the Resource, the plan lists the descendants and netblocks moving along",
+				Optional:    true,
+			},
 			"slug": schema.StringAttribute{
-				Description: "Resource Slug",
+				Description: "Resource Slug, lowercase letters and digits separated by - or _. ProVision generates it from the name when it is not set",
+				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					resourceSlug(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Description: "Resource Type",
@@ -88,7 +106,7 @@ func (r *pvresourceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 			},
 			"attrs": schema.MapAttribute{
-				Description: "Resource Attributes List",
+				Description: "Resource Attributes List, names and values are checked against the attribute definitions of the resource type and sent in the form ProVision stores, Ex: true is sent as 1 for checkboxes. It owns every attribute of the Resource when it is set. Leave it unset to manage some attributes with provision6connect_resource_attributes instead.",
 				ElementType: types.StringType,
 				Optional:    true,
 			},
@@ -109,14 +127,14 @@ func (r *pvresourceResource) Create(ctx context.Context, req resource.CreateRequ
 	newResource := provisionclient.Resource{
 		Name:  plan.Name.ValueString(),
 		Type:  plan.Type.ValueString(),
-		Attrs: plan.Attrs,
+		Attrs: normalizeResourceAttrs(r.client, plan.Type.ValueString(), plan.Attrs),
 	}
 
 	if !plan.ParentID.IsNull() {
 		newResource.ParentID = provisionclient.PVID(plan.ParentID.ValueString())
 	}
 
-	if !plan.Slug.IsNull() {
+	if !plan.Slug.IsNull() && !plan.Slug.IsUnknown() {
 		newResource.Slug = plan.Slug.ValueString()
 	}
 
@@ -133,7 +151,10 @@ func (r *pvresourceResource) Create(ctx context.Context, req resource.CreateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(string(pvresource.ID))
-	plan.ParentID = types.StringValue(string(pvresource.ParentID))
+	// parent_id stays null when it is not set, ProVision picks the parent then
+	if !plan.ParentID.IsNull() {
+		plan.ParentID = types.StringValue(string(pvresource.ParentID))
+	}
 	plan.Modified = types.StringValue(pvresource.Modified)
 	plan.Slug = types.StringValue(pvresource.Slug)
 	plan.Type = types.StringValue(pvresource.Type)
@@ -148,9 +169,115 @@ func (r *pvresourceResource) Create(ctx context.Context, req resource.CreateRequ
 
 }
 
+// ModifyPlan checks the attributes against the definitions of the resource type
+// when the attributes or the type changed.
+func (r *pvresourceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	r.checkReparent(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attribute values may not be known yet, they are read as a map value
+	var planType types.String
+	var planAttrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &planType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attrs"), &planAttrs)...)
+	if resp.Diagnostics.HasError() || planType.IsUnknown() || planAttrs.IsNull() || planAttrs.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateType types.String
+		var stateAttrs types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &stateType)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("attrs"), &stateAttrs)...)
+		if resp.Diagnostics.HasError() || (stateType.Equal(planType) && stateAttrs.Equal(planAttrs)) {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(checkResourceAttrs(r.client, planType.ValueString(), knownMapValues(planAttrs), path.Root("attrs"))...)
+}
+
+// resourceParentID returns the parent of a resource, a null, empty or 0 parent_id
+// all place the resource at the top level.
+func resourceParentID(parentID types.String) string {
+	if parentID.IsNull() || parentID.ValueString() == "0" {
+		return ""
+	}
+	return parentID.ValueString()
+}
+
+// checkReparent validates a change of parent_id of an existing resource and reports
+// the descendants and netblocks that move along.
+func (r *pvresourceResource) checkReparent(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state pvresourceModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("parent_id"), &plan.ParentID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_reparent"), &plan.AllowReparent)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &state.ID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("parent_id"), &state.ParentID)...)
+	if resp.Diagnostics.HasError() || (!plan.ParentID.IsUnknown() && resourceParentID(plan.ParentID) == resourceParentID(state.ParentID)) {
+		return
+	}
+
+	if !plan.AllowReparent.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent_id"),
+			"ProVision Resource Reparent Not Allowed",
+			"Changing, setting or removing parent_id moves ProVision Resource ID "+state.ID.ValueString()+" with its descendants and netblocks, set allow_reparent = true to allow the move.",
+		)
+		return
+	}
+
+	// The new parent is checked again on apply when it is not known yet
+	if plan.ParentID.IsUnknown() {
+		return
+	}
+
+	move, err := checkResourceMove(r.client, state.ID.ValueString(), resourceParentID(plan.ParentID))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("parent_id"),
+			"Invalid ProVision Resource Reparent",
+			"Could not move ProVision Resource ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("parent_id"),
+		"ProVision Resource Moves",
+		move.summary(),
+	)
+}
+
 func (r *pvresourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if !strings.HasPrefix(req.ID, "slug:") {
+		// Retrieve import ID and save to id attribute
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	// Resolve slug:<value> to the ID of the resource
+	slug := strings.TrimPrefix(req.ID, "slug:")
+	pvresource, err := findResourceBySlug(&r.client.Resources, slug)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing ProVision Resource",
+			"Could not find ProVision Resource with slug "+slug+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), string(pvresource.ID))...)
 }
 
 // Read resource information
@@ -164,10 +291,7 @@ func (r *pvresourceResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get resources value 
-	resources, err := r.client.Resources.GetResources(&map[string]string{
-		"id":              state.ID.ValueString(),
-		"load_attributes": "1",
-	})
+	pvresource, err := getResourceByID(&r.client.Resources, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading ProVision Resource",
@@ -176,22 +300,22 @@ func (r *pvresourceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	if len(resources) == 0 {
-		resp.Diagnostics.AddError(
-			"Error Finding ProVision Resource",
-			"ProVision Resource has not been found ID "+state.ID.ValueString()+": "+err.Error(),
-		)
-		return
+	// parent_id is only refreshed when it is set, imported resources read it too
+	if !state.ParentID.IsNull() || state.Name.IsNull() {
+		state.ParentID = types.StringValue(string(pvresource.ParentID))
 	}
-
-	pvresource := resources[0]
-
-	state.ParentID = types.StringValue(string(pvresource.ParentID))
 	state.Modified = types.StringValue(pvresource.Modified)
 	state.Slug = types.StringValue(pvresource.Slug)
 	state.Name = types.StringValue(pvresource.Name)
-	state.Type = types.StringValue(pvresource.Type)
-	state.Attrs = pvresource.Attrs
+	// The API may not return the type, the one in the state is kept then
+	if pvresource.Type != "" {
+		state.Type = types.StringValue(pvresource.Type)
+	}
+	// Attributes are only refreshed when they are managed here, they may be left
+	// to provision6connect_resource_attributes otherwise
+	if state.Attrs != nil {
+		state.Attrs = resourceAttrsToState(r.client, state.Type.ValueString(), state.Attrs, pvresource.Attrs)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -210,18 +334,39 @@ func (r *pvresourceResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	tflog.Info(ctx, "Updating Resource ID "+plan.ID.ValueString())
+
+	var state pvresourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A move is checked again as the new parent may only be known now
+	if resourceParentID(plan.ParentID) != resourceParentID(state.ParentID) {
+		move, err := checkResourceMove(r.client, plan.ID.ValueString(), resourceParentID(plan.ParentID))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid ProVision Resource Reparent",
+				"Could not move ProVision Resource ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		tflog.Info(ctx, "Moving Resource ID "+plan.ID.ValueString()+". "+move.summary())
+	}
+
 	newResource := provisionclient.Resource{
 		ID:    provisionclient.PVID(plan.ID.ValueString()),
 		Name:  plan.Name.ValueString(),
 		Type:  plan.Type.ValueString(),
-		Attrs: plan.Attrs,
+		Attrs: normalizeResourceAttrs(r.client, plan.Type.ValueString(), plan.Attrs),
 	}
 
 	if !plan.ParentID.IsNull() {
 		newResource.ParentID = provisionclient.PVID(plan.ParentID.ValueString())
 	}
 
-	if !plan.Slug.IsNull() {
+	if !plan.Slug.IsNull() && !plan.Slug.IsUnknown() {
 		newResource.Slug = plan.Slug.ValueString()
 	}
 
@@ -237,7 +382,10 @@ func (r *pvresourceResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	// Map response body to schema and populate Computed attribute values
 	plan.ID = types.StringValue(string(pvresource.ID))
-	plan.ParentID = types.StringValue(string(pvresource.ParentID))
+	// parent_id stays null when it is not set, ProVision picks the parent then
+	if !plan.ParentID.IsNull() {
+		plan.ParentID = types.StringValue(string(pvresource.ParentID))
+	}
 	plan.Modified = types.StringValue(pvresource.Modified)
 	plan.Slug = types.StringValue(pvresource.Slug)
 	plan.Type = types.StringValue(pvresource.Type)
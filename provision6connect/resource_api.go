@@ -0,0 +1,386 @@
+package provision6connect
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// getResourceByID returns the resource with its attributes loaded. It is read with
+// apiRequest as the client does not return the type of the resource.
+func getResourceByID(resources *provisionclient.ResourceMethods, id string) (*provisionclient.Resource, error) {
+	found, err := getResourceRecords(resources.Client, map[string]string{
+		"id":              id,
+		"load_attributes": "1",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range found {
+		if string(record.ID) == id {
+			resource := record.toResource()
+			return &resource, nil
+		}
+	}
+
+	return nil, fmt.Errorf("ProVision Resource ID %s has not been found", id)
+}
+
+// findResourceBySlug returns the resource with the slug, slugs are unique in ProVision.
+func findResourceBySlug(resources *provisionclient.ResourceMethods, slug string) (*provisionclient.Resource, error) {
+	found, err := resources.GetResources(&map[string]string{
+		"slug":            slug,
+		"load_attributes": "1",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The API matches loosely, only the exact slug is kept
+	for _, resource := range found {
+		if resource.Slug == slug {
+			return &resource, nil
+		}
+	}
+
+	return nil, fmt.Errorf("ProVision Resource with slug %s has not been found", slug)
+}
+
+// resourceRecord is a resource as returned by the ProVision resources API. The client
+// drops the type and the attribute values that are not strings, they are kept here.
+type resourceRecord struct {
+	ID       provisionclient.PVID `json:"id"`
+	ParentID provisionclient.PVID `json:"parent_id"`
+	Name     string               `json:"name"`
+	Slug     string               `json:"slug"`
+	Type     string               `json:"type"`
+	Date     string               `json:"date"`
+	Modified string               `json:"modified"`
+	Attrs    interface{}          `json:"attrs"`
+}
+
+// getResourceRecords returns the resources matching the filters as sent by the API.
+func getResourceRecords(client *provisionclient.Client, filters map[string]string) ([]resourceRecord, error) {
+	records := []resourceRecord{}
+	err := apiRequest(client, "GET", "/resources", &filters, nil, &records)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// rawAttrs returns a copy of the attributes with their values as sent by the API,
+// empty attributes come as a list instead of an object.
+func (record resourceRecord) rawAttrs() map[string]interface{} {
+	attrs := map[string]interface{}{}
+	if values, ok := record.Attrs.(map[string]interface{}); ok {
+		for key, value := range values {
+			attrs[key] = value
+		}
+	}
+	return attrs
+}
+
+// toResource returns the resource with the attribute values as strings, the way
+// they are set in Terraform.
+func (record resourceRecord) toResource() provisionclient.Resource {
+	resource := provisionclient.Resource{
+		ID:       record.ID,
+		ParentID: record.ParentID,
+		Name:     record.Name,
+		Slug:     record.Slug,
+		Type:     record.Type,
+		Date:     record.Date,
+		Modified: record.Modified,
+	}
+
+	attrs := record.rawAttrs()
+	if len(attrs) != 0 {
+		resource.Attrs = make(map[string]string, len(attrs))
+		for key, value := range attrs {
+			switch value := value.(type) {
+			case nil:
+			case string:
+				resource.Attrs[key] = value
+			case bool:
+				resource.Attrs[key] = strconv.FormatBool(value)
+			case float64:
+				resource.Attrs[key] = strconv.FormatFloat(value, 'f', -1, 64)
+			default:
+				encoded, _ := json.Marshal(value)
+				resource.Attrs[key] = string(encoded)
+			}
+		}
+	}
+	return resource
+}
+
+// resourceAttrLocks serializes the attribute updates of each resource, they are read
+// and written back as a whole.
+var resourceAttrLocks sync.Map
+
+// updateResourceAttrs sets and removes attributes of a resource and leaves the other
+// ones alone, the values set are normalized for the resource type. The attributes
+// are read first as the ones sent replace all the current ones, only the attributes
+// are sent back so the other fields of the resource are never overwritten.
+func updateResourceAttrs(client *provisionclient.Client, id string, set map[string]string, remove []string) error {
+	lock, _ := resourceAttrLocks.LoadOrStore(client.HostURL+"/"+id, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	found, err := getResourceRecords(client, map[string]string{
+		"id":              id,
+		"load_attributes": "1",
+	})
+	if err != nil {
+		return err
+	}
+	if len(found) == 0 || string(found[0].ID) != id {
+		return fmt.Errorf("ProVision Resource ID %s has not been found", id)
+	}
+	current := found[0]
+
+	// Values that are not strings are sent back untouched
+	attrs := current.rawAttrs()
+	for _, key := range remove {
+		delete(attrs, key)
+	}
+	for key, value := range normalizeResourceAttrs(client, current.Type, set) {
+		attrs[key] = value
+	}
+
+	return apiRequest(client, "PATCH", "/resources/"+id, nil, map[string]interface{}{
+		"attrs": attrs,
+	}, nil)
+}
+
+// getResourceChildren returns every direct child of the resource, reading all the
+// pages of the list.
+func getResourceChildren(client *provisionclient.Client, id string) ([]provisionclient.Resource, error) {
+	filters := map[string]string{
+		"parent_id":       id,
+		"load_attributes": "1",
+	}
+	records, _, err := fetchAllPages(func(offset, limit int) ([]resourceRecord, error) {
+		return getResourceRecords(client, pageFilters(filters, offset, limit))
+	}, func(record resourceRecord) string {
+		return string(record.ID)
+	}, 0, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+
+	children := []provisionclient.Resource{}
+	for _, record := range records {
+		// The API may ignore the filter for unknown parents, only direct children are kept
+		if string(record.ParentID) == id {
+			children = append(children, record.toResource())
+		}
+	}
+	return children, nil
+}
+
+// getResourceNetblocks returns every netblock assigned to the resource itself,
+// reading all the pages of the list.
+func getResourceNetblocks(client *provisionclient.Client, id string) ([]provisionclient.Netblock, error) {
+	filters := map[string]string{
+		"resource_id": id,
+	}
+	found, _, err := fetchAllPages(func(offset, limit int) ([]provisionclient.Netblock, error) {
+		page := pageFilters(filters, offset, limit)
+		return client.IPAM.GetNetblocks(&page)
+	}, func(netblock provisionclient.Netblock) string {
+		return string(netblock.ID)
+	}, 0, math.MaxInt)
+	if err != nil {
+		return nil, err
+	}
+
+	netblocks := []provisionclient.Netblock{}
+	for _, netblock := range found {
+		if string(netblock.ResourceID) == id {
+			netblocks = append(netblocks, netblock)
+		}
+	}
+	return netblocks, nil
+}
+
+// resourceTreeNode is a resource found under the root of a tree walk, with its depth
+// below the root and the IDs of the resources from the root down to it.
+type resourceTreeNode struct {
+	Resource provisionclient.Resource
+	Depth    int
+	Path     []string
+}
+
+// walkResourceTree returns the root resource and its descendants in depth first
+// order, children sorted by name. A negative maxDepth walks the whole tree, resources
+// already visited are skipped so a loop in the parent_id links can not hang the walk.
+func walkResourceTree(resources *provisionclient.ResourceMethods, rootID string, maxDepth int) ([]resourceTreeNode, error) {
+	root, err := getResourceByID(resources, rootID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := []resourceTreeNode{}
+	visited := map[string]bool{}
+
+	var walk func(resource provisionclient.Resource, depth int, parentPath []string) error
+	walk = func(resource provisionclient.Resource, depth int, parentPath []string) error {
+		id := string(resource.ID)
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+
+		path := append(append([]string{}, parentPath...), id)
+		nodes = append(nodes, resourceTreeNode{
+			Resource: resource,
+			Depth:    depth,
+			Path:     path,
+		})
+
+		if maxDepth >= 0 && depth >= maxDepth {
+			return nil
+		}
+
+		children, err := getResourceChildren(resources.Client, id)
+		if err != nil {
+			return fmt.Errorf("could not read the children of ProVision Resource ID %s: %w", id, err)
+		}
+
+		sort.SliceStable(children, func(i, j int) bool {
+			if children[i].Name != children[j].Name {
+				return children[i].Name < children[j].Name
+			}
+			return children[i].ID < children[j].ID
+		})
+
+		for _, child := range children {
+			if err := walk(child, depth+1, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(*root, 0, nil); err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+// resourceParentChain returns the ancestors of a resource, its parent first and the
+// top level resource last. The walk stops at a loop in the parent_id links.
+func resourceParentChain(resources *provisionclient.ResourceMethods, resource provisionclient.Resource) ([]provisionclient.Resource, error) {
+	chain := []provisionclient.Resource{}
+	visited := map[string]bool{string(resource.ID): true}
+
+	parentID := string(resource.ParentID)
+	for parentID != "" && parentID != "0" && !visited[parentID] {
+		visited[parentID] = true
+
+		parent, err := getResourceByID(resources, parentID)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, *parent)
+		parentID = string(parent.ParentID)
+	}
+
+	return chain, nil
+}
+
+// resourceMove describes a resource moved to a new parent, with the descendants and
+// the netblocks that move along with it.
+type resourceMove struct {
+	Parent      provisionclient.Resource
+	Descendants []provisionclient.Resource
+	Netblocks   []provisionclient.Netblock
+}
+
+// checkResourceMove checks that the resource can be moved under parentID, the parent
+// must exist and must not be the resource or one of its descendants. An empty
+// parentID moves the resource to the top level.
+func checkResourceMove(client *provisionclient.Client, id, parentID string) (*resourceMove, error) {
+	if parentID == id {
+		return nil, fmt.Errorf("ProVision Resource ID %s can not be its own parent", id)
+	}
+
+	parent := &provisionclient.Resource{}
+	if parentID != "" {
+		var err error
+		parent, err = getResourceByID(&client.Resources, parentID)
+		if err != nil {
+			return nil, fmt.Errorf("the new parent does not exist: %w", err)
+		}
+
+		ancestors, err := resourceParentChain(&client.Resources, *parent)
+		if err != nil {
+			return nil, err
+		}
+		for _, ancestor := range ancestors {
+			if string(ancestor.ID) == id {
+				return nil, fmt.Errorf("ProVision Resource ID %s is an ancestor of the new parent %s, the move would create a cycle", id, parentID)
+			}
+		}
+	}
+
+	nodes, err := walkResourceTree(&client.Resources, id, -1)
+	if err != nil {
+		return nil, err
+	}
+
+	move := &resourceMove{
+		Parent:      *parent,
+		Descendants: []provisionclient.Resource{},
+		Netblocks:   []provisionclient.Netblock{},
+	}
+	for _, node := range nodes {
+		if node.Depth > 0 {
+			move.Descendants = append(move.Descendants, node.Resource)
+		}
+
+		netblocks, err := getResourceNetblocks(client, string(node.Resource.ID))
+		if err != nil {
+			return nil, fmt.Errorf("could not read the netblocks of ProVision Resource ID %s: %w", node.Resource.ID, err)
+		}
+		move.Netblocks = append(move.Netblocks, netblocks...)
+	}
+
+	return move, nil
+}
+
+// summary describes what moves along with the resource.
+func (move *resourceMove) summary() string {
+	descendants := make([]string, 0, len(move.Descendants))
+	for _, descendant := range move.Descendants {
+		descendants = append(descendants, descendant.Name+" ("+string(descendant.ID)+")")
+	}
+	netblocks := make([]string, 0, len(move.Netblocks))
+	for _, netblock := range move.Netblocks {
+		netblocks = append(netblocks, netblock.CIDR)
+	}
+
+	destination := "to the top level"
+	if move.Parent.ID != "" {
+		destination = fmt.Sprintf("under %s (%s)", move.Parent.Name, move.Parent.ID)
+	}
+	summary := fmt.Sprintf("The Resource moves %s with %d descendant Resources and %d netblocks.", destination, len(descendants), len(netblocks))
+	if len(descendants) != 0 {
+		summary += "\nResources: " + strings.Join(descendants, ", ")
+	}
+	if len(netblocks) != 0 {
+		summary += "\nNetblocks: " + strings.Join(netblocks, ", ")
+	}
+	return summary
+}
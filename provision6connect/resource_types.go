@@ -0,0 +1,279 @@
+package provision6connect
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourceSection is a section of the ProVision resource type configuration, its
+// categories hold the definitions of the attributes of the resource type.
+type resourceSection struct {
+	ID           provisionclient.PVID `json:"id,omitempty"`
+	Name         string               `json:"name"`
+	ResourceType string               `json:"resource_type"`
+	Categories   []resourceCategory   `json:"categories,omitempty"`
+}
+
+// resourceCategory groups attribute definitions inside a section.
+type resourceCategory struct {
+	ID         provisionclient.PVID     `json:"id,omitempty"`
+	Name       string                   `json:"name"`
+	Attributes []resourceAttrDefinition `json:"attributes,omitempty"`
+}
+
+// resourceAttrDefinition defines an attribute of a resource type.
+type resourceAttrDefinition struct {
+	Name      string   `json:"name"`
+	Label     string   `json:"label,omitempty"`
+	FieldType string   `json:"field_type"`
+	Options   []string `json:"options,omitempty"`
+}
+
+// resourceAttrDefinitions are the attribute definitions of a resource type by name.
+type resourceAttrDefinitions map[string]resourceAttrDefinition
+
+func getResourceSections(client *provisionclient.Client, resourceType string) ([]resourceSection, error) {
+	sections := []resourceSection{}
+	err := apiRequest(client, "GET", "/sections", &map[string]string{
+		"resource_type":   resourceType,
+		"load_attributes": "1",
+	}, nil, &sections)
+	if err != nil {
+		return nil, err
+	}
+
+	return sections, nil
+}
+
+// resourceAttrDefinitionsCache keeps the definitions of each resource type for the
+// life of the provider, they are read once per host and type.
+var resourceAttrDefinitionsCache sync.Map
+
+// getResourceAttrDefinitions returns the attribute definitions of a resource type.
+// Types without any definition return an empty map, attributes are not checked then.
+func getResourceAttrDefinitions(client *provisionclient.Client, resourceType string) (resourceAttrDefinitions, error) {
+	key := client.HostURL + " " + resourceType
+	if cached, ok := resourceAttrDefinitionsCache.Load(key); ok {
+		return cached.(resourceAttrDefinitions), nil
+	}
+
+	sections, err := getResourceSections(client, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := resourceAttrDefinitions{}
+	for _, section := range sections {
+		if section.ResourceType != "" && section.ResourceType != resourceType {
+			continue
+		}
+		for _, category := range section.Categories {
+			for _, attribute := range category.Attributes {
+				definitions[attribute.Name] = attribute
+			}
+		}
+	}
+
+	resourceAttrDefinitionsCache.Store(key, definitions)
+	return definitions, nil
+}
+
+// resourceAttrBooleans maps the accepted boolean values to the form ProVision stores.
+var resourceAttrBooleans = map[string]string{
+	"1": "1", "true": "1", "yes": "1", "on": "1",
+	"0": "0", "false": "0", "no": "0", "off": "0",
+}
+
+// normalize validates the value of an attribute and returns it in the form stored
+// by ProVision. Attributes without definition are only accepted when the type has
+// no definitions at all.
+func (definitions resourceAttrDefinitions) normalize(name, value string) (string, error) {
+	if len(definitions) == 0 {
+		return value, nil
+	}
+
+	definition, ok := definitions[name]
+	if !ok {
+		names := make([]string, 0, len(definitions))
+		for known := range definitions {
+			names = append(names, known)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("%s is not an attribute of this resource type, the attributes are %s", name, strings.Join(names, ", "))
+	}
+
+	// Empty values clear the attribute whatever its type
+	if value == "" {
+		return value, nil
+	}
+
+	switch strings.ToLower(definition.FieldType) {
+	case "checkbox", "boolean", "bool":
+		normalized, ok := resourceAttrBooleans[strings.ToLower(strings.TrimSpace(value))]
+		if !ok {
+			return "", fmt.Errorf("%s must be a boolean, got %q", name, value)
+		}
+		return normalized, nil
+
+	case "integer", "int":
+		number, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("%s must be an integer, got %q", name, value)
+		}
+		return strconv.FormatInt(number, 10), nil
+
+	case "number", "decimal", "float":
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return "", fmt.Errorf("%s must be a number, got %q", name, value)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+
+	case "date":
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("%s must be a date in YYYY-MM-DD format, got %q", name, value)
+		}
+		return date.Format("2006-01-02"), nil
+
+	case "ip", "ipaddress":
+		ip, err := netip.ParseAddr(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("%s must be an IP address, got %q", name, value)
+		}
+		return ip.String(), nil
+
+	case "cidr", "prefix":
+		prefix, err := netip.ParsePrefix(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("%s must be a prefix in CIDR format, got %q", name, value)
+		}
+		return prefix.String(), nil
+
+	case "select", "dropdown", "radio":
+		for _, option := range definition.Options {
+			if strings.EqualFold(option, strings.TrimSpace(value)) {
+				return option, nil
+			}
+		}
+		return "", fmt.Errorf("%s must be one of %s, got %q", name, strings.Join(definition.Options, ", "), value)
+	}
+
+	return value, nil
+}
+
+// equal reports whether two values of an attribute are stored the same by ProVision.
+func (definitions resourceAttrDefinitions) equal(name, a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	normalizedA, err := definitions.normalize(name, a)
+	if err != nil {
+		return false
+	}
+	normalizedB, err := definitions.normalize(name, b)
+	if err != nil {
+		return false
+	}
+	return normalizedA == normalizedB
+}
+
+// checkResourceAttrs validates the attributes against the definitions of the resource
+// type. Definitions that can not be read are only reported as a warning.
+func checkResourceAttrs(client *provisionclient.Client, resourceType string, attrs map[string]string, attrsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definitions, err := getResourceAttrDefinitions(client, resourceType)
+	if err != nil {
+		diags.AddAttributeWarning(
+			attrsPath,
+			"Unable to Read ProVision Resource Type Definitions",
+			"The attributes could not be checked against the definitions of the resource type "+resourceType+": "+err.Error(),
+		)
+		return diags
+	}
+
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, err := definitions.normalize(name, attrs[name]); err != nil {
+			diags.AddAttributeError(
+				attrsPath.AtMapKey(name),
+				"Invalid ProVision Resource Attribute",
+				err.Error(),
+			)
+		}
+	}
+	return diags
+}
+
+// normalizeResourceAttrs returns the attributes in the form stored by ProVision,
+// values that can not be normalized are sent as they are.
+func normalizeResourceAttrs(client *provisionclient.Client, resourceType string, attrs map[string]string) map[string]string {
+	if attrs == nil {
+		return nil
+	}
+
+	definitions, err := getResourceAttrDefinitions(client, resourceType)
+	if err != nil {
+		return attrs
+	}
+
+	normalized := make(map[string]string, len(attrs))
+	for name, value := range attrs {
+		if n, err := definitions.normalize(name, value); err == nil {
+			normalized[name] = n
+		} else {
+			normalized[name] = value
+		}
+	}
+	return normalized
+}
+
+// resourceAttrsToState returns the attributes read from ProVision, values that only
+// differ from the ones in state in their form are kept.
+func resourceAttrsToState(client *provisionclient.Client, resourceType string, state, server map[string]string) map[string]string {
+	if server == nil {
+		return nil
+	}
+
+	definitions, err := getResourceAttrDefinitions(client, resourceType)
+	if err != nil {
+		definitions = resourceAttrDefinitions{}
+	}
+
+	refreshed := make(map[string]string, len(server))
+	for name, value := range server {
+		if current, ok := state[name]; ok && definitions.equal(name, current, value) {
+			value = current
+		}
+		refreshed[name] = value
+	}
+	return refreshed
+}
+
+// knownMapValues returns the known string values of a map, unknown ones are skipped.
+func knownMapValues(values types.Map) map[string]string {
+	known := make(map[string]string, len(values.Elements()))
+	for name, value := range values.Elements() {
+		if value, ok := value.(types.String); ok && !value.IsUnknown() && !value.IsNull() {
+			known[name] = value.ValueString()
+		}
+	}
+	return known
+}
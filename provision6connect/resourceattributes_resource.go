@@ -0,0 +1,280 @@
+package provision6connect
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &resourceattributesResource{}
+	_ resource.ResourceWithConfigure   = &resourceattributesResource{}
+	_ resource.ResourceWithImportState = &resourceattributesResource{}
+	_ resource.ResourceWithModifyPlan  = &resourceattributesResource{}
+)
+
+// NewResourceattributesResource is a helper function to simplify the provider implementation.
+func NewResourceattributesResource() resource.Resource {
+	return &resourceattributesResource{}
+}
+
+// resourceattributesModel maps resource attributes schema data.
+type resourceattributesModel struct {
+	ID         types.String      `tfsdk:"id"`
+	ResourceID types.String      `tfsdk:"resource_id"`
+	Attrs      map[string]string `tfsdk:"attrs"`
+}
+
+// resourceattributesResource is the resource implementation.
+type resourceattributesResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourceattributesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *resourceattributesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_attributes"
+}
+
+// Schema defines the schema for the resource.
+func (r *resourceattributesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource Attributes Resource that manages only the listed attributes of an existing ProVision Resource. Attributes set by ProVision or other tools are left alone and do not show up as drift. " +
+			"A Resource must be managed by a single provision6connect_resource_attributes, several of them on the same Resource would overwrite and remove the attributes of each other. " +
+			"It can be imported with <resource_id>/<key>,<key> to pick the managed attributes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the Resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "Numeric identifier of the Resource whose attributes are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attrs": schema.MapAttribute{
+				Description: "Attributes managed on the Resource, keys removed from it are removed from the Resource as well. Names and values are checked against the attribute definitions of the resource type and sent in the form ProVision stores.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan checks the attributes against the definitions of the type of the
+// resource when they changed.
+func (r *resourceattributesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// Attribute values may not be known yet, they are read as a map value
+	var resourceID types.String
+	var planAttrs types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("resource_id"), &resourceID)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("attrs"), &planAttrs)...)
+	if resp.Diagnostics.HasError() || resourceID.IsUnknown() || planAttrs.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateAttrs types.Map
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("attrs"), &stateAttrs)...)
+		if resp.Diagnostics.HasError() || stateAttrs.Equal(planAttrs) {
+			return
+		}
+	}
+
+	pvresource, err := getResourceByID(&r.client.Resources, resourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("resource_id"),
+			"Unable to Read ProVision Resource",
+			"The attributes could not be checked against the definitions of the resource type: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkResourceAttrs(r.client, pvresource.Type, knownMapValues(planAttrs), path.Root("attrs"))...)
+}
+
+// Create a new resource
+func (r *resourceattributesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan resourceattributesModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Merge the attributes into the ones of the resource
+	err := updateResourceAttrs(r.client, plan.ResourceID.ValueString(), plan.Attrs, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Setting ProVision Resource Attributes",
+			"Could not set the attributes of ProVision Resource ID "+plan.ResourceID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(plan.ResourceID.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceattributesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and resource_id attributes, the keys after
+	// the slash are the managed attributes
+	id, keys, _ := strings.Cut(req.ID, "/")
+
+	attrs := map[string]string{}
+	for _, key := range strings.Split(keys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			attrs[key] = ""
+		}
+	}
+	if id == "" || len(attrs) == 0 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format: <resource_id>/<key>,<key>. Got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("attrs"), attrs)...)
+}
+
+// Read resource information
+func (r *resourceattributesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state resourceattributesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pvresource, err := getResourceByID(&r.client.Resources, state.ResourceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision Resource Attributes",
+			"Could not read ProVision Resource ID "+state.ResourceID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only the managed attributes are refreshed
+	attrs := map[string]string{}
+	for key := range state.Attrs {
+		if value, ok := pvresource.Attrs[key]; ok {
+			attrs[key] = value
+		}
+	}
+	state.Attrs = resourceAttrsToState(r.client, pvresource.Type, state.Attrs, attrs)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourceattributesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan resourceattributesModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating Attributes of Resource ID "+plan.ResourceID.ValueString())
+
+	var state resourceattributesModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keys no longer managed are removed from the resource
+	err := updateResourceAttrs(r.client, plan.ResourceID.ValueString(), plan.Attrs, removedAttrKeys(state.Attrs, plan.Attrs))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating ProVision Resource Attributes",
+			"Could not update the attributes of ProVision Resource ID "+plan.ResourceID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *resourceattributesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state resourceattributesModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove the managed attributes, the resource itself is left alone
+	err := updateResourceAttrs(r.client, state.ResourceID.ValueString(), nil, removedAttrKeys(state.Attrs, nil))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision Resource Attributes",
+			"Could not remove the attributes of ProVision Resource ID "+state.ResourceID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// removedAttrKeys returns the keys of previous missing from current, sorted.
+func removedAttrKeys(previous, current map[string]string) []string {
+	removed := []string{}
+	for key := range previous {
+		if _, ok := current[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
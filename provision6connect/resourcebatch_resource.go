@@ -0,0 +1,712 @@
+package provision6connect
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// resourceBatchConcurrency is the number of API calls running at the same time when
+// concurrency is not set.
+const resourceBatchConcurrency = 8
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &resourcebatchResource{}
+	_ resource.ResourceWithConfigure      = &resourcebatchResource{}
+	_ resource.ResourceWithValidateConfig = &resourcebatchResource{}
+	_ resource.ResourceWithModifyPlan     = &resourcebatchResource{}
+)
+
+// NewResourcebatchResource is a helper function to simplify the provider implementation.
+func NewResourcebatchResource() resource.Resource {
+	return &resourcebatchResource{}
+}
+
+// resourcebatchModel maps resource batch schema data.
+type resourcebatchModel struct {
+	ID          types.String                        `tfsdk:"id"`
+	Concurrency types.Int64                         `tfsdk:"concurrency"`
+	Resources   map[string]resourcebatchMemberModel `tfsdk:"resources"`
+	Failures    types.Map                           `tfsdk:"failures"`
+}
+
+// resourcebatchMemberModel maps a resource of the batch.
+type resourcebatchMemberModel struct {
+	ID       types.String `tfsdk:"id"`
+	ParentID types.String `tfsdk:"parent_id"`
+	Name     types.String `tfsdk:"name"`
+	Slug     types.String `tfsdk:"slug"`
+	Type     types.String `tfsdk:"type"`
+	Modified types.String `tfsdk:"modified"`
+	Attrs    types.Map    `tfsdk:"attrs"`
+}
+
+// resourcebatchResult is the outcome of the API call made for a member of the batch.
+type resourcebatchResult struct {
+	resource *provisionclient.Resource
+	err      error
+}
+
+// forEachConcurrently calls fn for every key, at most concurrency calls at a time.
+func forEachConcurrently(keys []string, concurrency int, fn func(key string)) {
+	queue := make(chan string)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency && i < len(keys); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range queue {
+				fn(key)
+			}
+		}()
+	}
+
+	for _, key := range keys {
+		queue <- key
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// sortedMemberKeys returns the keys of the members, sorted so calls and diagnostics
+// keep the same order between runs.
+func sortedMemberKeys(members map[string]resourcebatchMemberModel) []string {
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// memberChanged reports whether the settings of a member differ from its state.
+func memberChanged(plan, state resourcebatchMemberModel) bool {
+	return !plan.Name.Equal(state.Name) ||
+		!plan.Type.Equal(state.Type) ||
+		(!plan.ParentID.IsUnknown() && !plan.ParentID.Equal(state.ParentID)) ||
+		(!plan.Slug.IsUnknown() && !plan.Slug.Equal(state.Slug)) ||
+		!plan.Attrs.Equal(state.Attrs)
+}
+
+// memberToResource returns the resource to send for a member of the batch.
+func (r *resourcebatchResource) memberToResource(ctx context.Context, member resourcebatchMemberModel) (provisionclient.Resource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newResource := provisionclient.Resource{
+		ID:   provisionclient.PVID(member.ID.ValueString()),
+		Name: member.Name.ValueString(),
+		Type: member.Type.ValueString(),
+	}
+	if !member.ParentID.IsNull() && !member.ParentID.IsUnknown() && member.ParentID.ValueString() != "" {
+		newResource.ParentID = provisionclient.PVID(member.ParentID.ValueString())
+	}
+	if !member.Slug.IsNull() && !member.Slug.IsUnknown() {
+		newResource.Slug = member.Slug.ValueString()
+	}
+	if !member.Attrs.IsNull() && !member.Attrs.IsUnknown() {
+		attrs := map[string]string{}
+		diags.Append(member.Attrs.ElementsAs(ctx, &attrs, false)...)
+		newResource.Attrs = normalizeResourceAttrs(r.client, newResource.Type, attrs)
+	}
+
+	return newResource, diags
+}
+
+// memberToState maps the outcome of the API call to the member. Members that could
+// not be created keep an empty id so they are created again on the next apply.
+func memberToState(member *resourcebatchMemberModel, result resourcebatchResult) {
+	if result.err != nil || result.resource == nil {
+		if member.ID.IsUnknown() {
+			member.ID = types.StringValue("")
+		}
+		if member.ParentID.IsUnknown() {
+			member.ParentID = types.StringValue("")
+		}
+		if member.Slug.IsUnknown() {
+			member.Slug = types.StringValue("")
+		}
+		if member.Modified.IsUnknown() {
+			member.Modified = types.StringValue("")
+		}
+		return
+	}
+
+	member.ID = types.StringValue(string(result.resource.ID))
+	member.ParentID = types.StringValue(string(result.resource.ParentID))
+	member.Slug = types.StringValue(result.resource.Slug)
+	member.Modified = types.StringValue(result.resource.Modified)
+}
+
+// resourcebatchResource is the resource implementation.
+type resourcebatchResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourcebatchResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *resourcebatchResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_batch"
+}
+
+// Schema defines the schema for the resource.
+func (r *resourcebatchResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource Batch Resource that manages many ProVision Resources with concurrent API calls instead of one provision6connect_resource each. " +
+			"A Resource that fails to be created or updated does not stop the others, the failure is reported as a warning and in failures, and the Resource is retried on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the batch, generated on creation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Description: "Number of API calls running at the same time, between 1 and 32, defaults to 8",
+				Optional:    true,
+			},
+			"resources": schema.MapNestedAttribute{
+				Description: "Resources of the batch by key, the keys only exist in Terraform",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the Resource, empty when it could not be created",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"name": schema.StringAttribute{
+							Description: "Resource Name",
+							Required:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Resource Type",
+							Required:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "Parent Resource identifier Number, if it is not set ProVision will set TLR by default.",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"slug": schema.StringAttribute{
+							Description: "Resource Slug, ProVision generates it from the name when it is not set",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								resourceSlug(),
+							},
+						},
+						"modified": schema.StringAttribute{
+							Description: "Date and Time of the last modification",
+							Computed:    true,
+						},
+						"attrs": schema.MapAttribute{
+							Description: "Resource Attributes List, checked and normalized like the attrs of provision6connect_resource",
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"failures": schema.MapAttribute{
+				Description: "Error of each Resource that failed during the last apply, by key",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the concurrency of the batch.
+func (r *resourcebatchResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var concurrency types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("concurrency"), &concurrency)...)
+	if resp.Diagnostics.HasError() || concurrency.IsNull() || concurrency.IsUnknown() {
+		return
+	}
+
+	if concurrency.ValueInt64() < 1 || concurrency.ValueInt64() > 32 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("concurrency"),
+			"Invalid Resource Batch Concurrency",
+			"concurrency must be between 1 and 32, got "+strconv.FormatInt(concurrency.ValueInt64(), 10)+".",
+		)
+	}
+}
+
+// ModifyPlan plans the creation of the members that failed before and checks the
+// attributes of the changed members against the definitions of their type.
+func (r *resourcebatchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	// The members may not be known yet when they come from other resources
+	var members types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("resources"), &members)...)
+	if resp.Diagnostics.HasError() || members.IsUnknown() {
+		return
+	}
+	for _, member := range members.Elements() {
+		if member.IsUnknown() {
+			return
+		}
+	}
+
+	var plan resourcebatchModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config resourcebatchModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := resourcebatchModel{}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	retry := false
+	for _, key := range sortedMemberKeys(plan.Resources) {
+		member := plan.Resources[key]
+		current, exists := state.Resources[key]
+
+		if exists && current.ID.ValueString() == "" {
+			member.ID = types.StringUnknown()
+			member.Modified = types.StringUnknown()
+			// The values ProVision sets on creation are not known until then
+			if config.Resources[key].ParentID.IsNull() {
+				member.ParentID = types.StringUnknown()
+			}
+			if config.Resources[key].Slug.IsNull() {
+				member.Slug = types.StringUnknown()
+			}
+			plan.Resources[key] = member
+			retry = true
+		}
+
+		if member.Type.IsUnknown() || member.Attrs.IsNull() || member.Attrs.IsUnknown() {
+			continue
+		}
+		if exists && member.Type.Equal(current.Type) && member.Attrs.Equal(current.Attrs) {
+			continue
+		}
+		resp.Diagnostics.Append(checkResourceAttrs(r.client, member.Type.ValueString(), knownMapValues(member.Attrs), path.Root("resources").AtMapKey(key).AtName("attrs"))...)
+	}
+
+	if retry {
+		plan.Failures = types.MapUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+	}
+}
+
+// apply creates, updates and deletes the members of the batch concurrently. Create
+// and update failures are reported as warnings and kept in failures, delete failures
+// are errors as the Resource would be left behind.
+func (r *resourcebatchResource) apply(ctx context.Context, plan *resourcebatchModel, state resourcebatchModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	concurrency := resourceBatchConcurrency
+	if !plan.Concurrency.IsNull() {
+		concurrency = int(plan.Concurrency.ValueInt64())
+	}
+
+	// Members are created when they are new or failed before, updated when changed
+	creates := []string{}
+	updates := []string{}
+	requests := map[string]provisionclient.Resource{}
+	for _, key := range sortedMemberKeys(plan.Resources) {
+		member := plan.Resources[key]
+		current, exists := state.Resources[key]
+
+		switch {
+		case !exists || current.ID.ValueString() == "":
+			creates = append(creates, key)
+		case memberChanged(member, current):
+			member.ID = current.ID
+			updates = append(updates, key)
+		default:
+			continue
+		}
+
+		newResource, d := r.memberToResource(ctx, member)
+		diags.Append(d...)
+		requests[key] = newResource
+	}
+
+	deletes := []string{}
+	for _, key := range sortedMemberKeys(state.Resources) {
+		if _, ok := plan.Resources[key]; !ok && state.Resources[key].ID.ValueString() != "" {
+			deletes = append(deletes, key)
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Info(ctx, "Applying Resource Batch "+plan.ID.ValueString()+": "+strconv.Itoa(len(creates))+" to create, "+strconv.Itoa(len(updates))+" to update, "+strconv.Itoa(len(deletes))+" to delete")
+
+	var mutex sync.Mutex
+	results := map[string]resourcebatchResult{}
+
+	forEachConcurrently(creates, concurrency, func(key string) {
+		newResource := requests[key]
+		newResource.ID = ""
+		created, err := r.client.Resources.AddResource(newResource)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		results[key] = resourcebatchResult{resource: created, err: err}
+	})
+
+	forEachConcurrently(updates, concurrency, func(key string) {
+		updated, err := r.client.Resources.UpdateResource(requests[key])
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		results[key] = resourcebatchResult{resource: updated, err: err}
+	})
+
+	forEachConcurrently(deletes, concurrency, func(key string) {
+		err := r.client.Resources.DeleteResourceByID(state.Resources[key].ID.ValueString())
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		results[key] = resourcebatchResult{err: err}
+	})
+
+	failures := map[string]string{}
+	for _, key := range sortedMemberKeys(plan.Resources) {
+		member := plan.Resources[key]
+		result, called := results[key]
+		if !called {
+			// Unchanged members keep the values read last
+			current := state.Resources[key]
+			member.ID = current.ID
+			member.ParentID = current.ParentID
+			member.Slug = current.Slug
+			member.Modified = current.Modified
+			plan.Resources[key] = member
+			continue
+		}
+
+		if result.err != nil {
+			failures[key] = result.err.Error()
+			diags.AddAttributeWarning(
+				path.Root("resources").AtMapKey(key),
+				"Error Applying ProVision Resource",
+				"Could not apply ProVision Resource "+key+" of the batch, it is retried on the next apply: "+result.err.Error(),
+			)
+		}
+
+		// Failed updates keep the planned values, the next refresh shows the drift
+		if result.err != nil && state.Resources[key].ID.ValueString() != "" {
+			current := state.Resources[key]
+			member.ID = current.ID
+			result = resourcebatchResult{resource: &provisionclient.Resource{
+				ID:       provisionclient.PVID(current.ID.ValueString()),
+				ParentID: provisionclient.PVID(current.ParentID.ValueString()),
+				Slug:     current.Slug.ValueString(),
+				Modified: current.Modified.ValueString(),
+			}}
+			if !member.ParentID.IsUnknown() {
+				result.resource.ParentID = provisionclient.PVID(member.ParentID.ValueString())
+			}
+			if !member.Slug.IsUnknown() {
+				result.resource.Slug = member.Slug.ValueString()
+			}
+		}
+
+		memberToState(&member, result)
+		plan.Resources[key] = member
+	}
+
+	for _, key := range deletes {
+		if err := results[key].err; err != nil {
+			diags.AddAttributeError(
+				path.Root("resources").AtMapKey(key),
+				"Error Deleting ProVision Resource",
+				"Could not delete ProVision Resource ID "+state.Resources[key].ID.ValueString()+" removed from the batch as "+key+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+
+	var d diag.Diagnostics
+	plan.Failures, d = types.MapValueFrom(ctx, types.StringType, failures)
+	diags.Append(d...)
+
+	return diags
+}
+
+// Create a new resource
+func (r *resourcebatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan resourcebatchModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(strconv.FormatInt(time.Now().UnixNano(), 36))
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, resourcebatchModel{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information
+func (r *resourcebatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state resourcebatchModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	concurrency := resourceBatchConcurrency
+	if !state.Concurrency.IsNull() {
+		concurrency = int(state.Concurrency.ValueInt64())
+	}
+
+	keys := []string{}
+	for _, key := range sortedMemberKeys(state.Resources) {
+		if state.Resources[key].ID.ValueString() != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	// Members are listed by parent so a page of children holds many of them
+	parents := []string{}
+	seenParents := map[string]bool{}
+	for _, key := range keys {
+		parentID := resourceParentID(state.Resources[key].ParentID)
+		if parentID != "" && !seenParents[parentID] {
+			seenParents[parentID] = true
+			parents = append(parents, parentID)
+		}
+	}
+
+	var mutex sync.Mutex
+	listed := map[string]provisionclient.Resource{}
+	listErrors := map[string]error{}
+	forEachConcurrently(parents, concurrency, func(parentID string) {
+		children, err := getResourceChildren(r.client, parentID)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		if err != nil {
+			listErrors[parentID] = err
+			return
+		}
+		for _, child := range children {
+			listed[string(child.ID)] = child
+		}
+	})
+
+	results := map[string]resourcebatchResult{}
+	unlisted := []string{}
+	for _, key := range keys {
+		member := state.Resources[key]
+		if err := listErrors[resourceParentID(member.ParentID)]; err != nil {
+			results[key] = resourcebatchResult{err: err}
+			continue
+		}
+		if child, ok := listed[member.ID.ValueString()]; ok {
+			results[key] = resourcebatchResult{resource: &child}
+			continue
+		}
+		unlisted = append(unlisted, key)
+	}
+
+	// Top level members and the ones moved or deleted outside of Terraform are read by ID
+	forEachConcurrently(unlisted, concurrency, func(key string) {
+		id := state.Resources[key].ID.ValueString()
+		found, err := getResourceRecords(r.client, map[string]string{
+			"id":              id,
+			"load_attributes": "1",
+		})
+
+		result := resourcebatchResult{err: err}
+		for _, record := range found {
+			if string(record.ID) == id {
+				resource := record.toResource()
+				result.resource = &resource
+			}
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		results[key] = result
+	})
+
+	for _, key := range keys {
+		member := state.Resources[key]
+		result := results[key]
+
+		if result.err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading ProVision Resource",
+				"Could not read ProVision Resource ID "+member.ID.ValueString()+" of the batch as "+key+": "+result.err.Error(),
+			)
+			continue
+		}
+
+		// Resources deleted outside of Terraform are created again on the next apply
+		if result.resource == nil {
+			member.ID = types.StringValue("")
+			state.Resources[key] = member
+			continue
+		}
+
+		pvresource := result.resource
+		member.ParentID = types.StringValue(string(pvresource.ParentID))
+		member.Name = types.StringValue(pvresource.Name)
+		member.Slug = types.StringValue(pvresource.Slug)
+		// The client does not return the type, the one in the state is kept then
+		if pvresource.Type != "" {
+			member.Type = types.StringValue(pvresource.Type)
+		}
+		member.Modified = types.StringValue(pvresource.Modified)
+
+		// Attributes are only refreshed when they are managed here
+		if !member.Attrs.IsNull() {
+			attrs := map[string]string{}
+			resp.Diagnostics.Append(member.Attrs.ElementsAs(ctx, &attrs, false)...)
+
+			var d diag.Diagnostics
+			member.Attrs, d = types.MapValueFrom(ctx, types.StringType, resourceAttrsToState(r.client, member.Type.ValueString(), attrs, pvresource.Attrs))
+			resp.Diagnostics.Append(d...)
+		}
+
+		state.Resources[key] = member
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourcebatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan resourcebatchModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating Resource Batch "+plan.ID.ValueString())
+
+	var state resourcebatchModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state is saved even when some deletes failed, so the applied changes are kept
+	resp.Diagnostics.Append(r.apply(ctx, &plan, state)...)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *resourcebatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state resourcebatchModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	concurrency := resourceBatchConcurrency
+	if !state.Concurrency.IsNull() {
+		concurrency = int(state.Concurrency.ValueInt64())
+	}
+
+	keys := []string{}
+	for _, key := range sortedMemberKeys(state.Resources) {
+		if state.Resources[key].ID.ValueString() != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	var mutex sync.Mutex
+	errs := map[string]error{}
+	forEachConcurrently(keys, concurrency, func(key string) {
+		err := r.client.Resources.DeleteResourceByID(state.Resources[key].ID.ValueString())
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		errs[key] = err
+	})
+
+	for _, key := range keys {
+		if errs[key] != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting ProVision Resource",
+				"Could not delete ProVision Resource ID "+state.Resources[key].ID.ValueString()+" of the batch as "+key+", unexpected error: "+errs[key].Error(),
+			)
+		}
+	}
+}
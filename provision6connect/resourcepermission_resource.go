@@ -0,0 +1,250 @@
+package provision6connect
+
+import (
+	"context"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &resourcepermissionResource{}
+	_ resource.ResourceWithConfigure   = &resourcepermissionResource{}
+	_ resource.ResourceWithImportState = &resourcepermissionResource{}
+)
+
+// NewResourcepermissionResource is a helper function to simplify the provider implementation.
+func NewResourcepermissionResource() resource.Resource {
+	return &resourcepermissionResource{}
+}
+
+// resourcepermissionModel maps resource permission schema data.
+type resourcepermissionModel struct {
+	ID         types.String `tfsdk:"id"`
+	ResourceID types.String `tfsdk:"resource_id"`
+	GroupID    types.String `tfsdk:"group_id"`
+	Access     types.String `tfsdk:"access"`
+	Recursive  types.Bool   `tfsdk:"recursive"`
+	Modified   types.String `tfsdk:"modified"`
+}
+
+func planToResourcePermission(plan resourcepermissionModel) resourcePermission {
+	permission := resourcePermission{
+		ID:         provisionclient.PVID(plan.ID.ValueString()),
+		ResourceID: provisionclient.PVID(plan.ResourceID.ValueString()),
+		GroupID:    provisionclient.PVID(plan.GroupID.ValueString()),
+		Access:     plan.Access.ValueString(),
+	}
+
+	// Permissions cover the whole subtree unless recursive is false
+	recursive := plan.Recursive.IsNull() || plan.Recursive.IsUnknown() || plan.Recursive.ValueBool()
+	permission.Recursive = &recursive
+
+	return permission
+}
+
+func resourcepermissionToState(state *resourcepermissionModel, permission *resourcePermission) {
+	state.ID = types.StringValue(string(permission.ID))
+	state.ResourceID = types.StringValue(string(permission.ResourceID))
+	state.GroupID = types.StringValue(string(permission.GroupID))
+	state.Access = types.StringValue(permission.Access)
+	state.Recursive = types.BoolValue(permission.Recursive == nil || *permission.Recursive)
+	state.Modified = types.StringValue(permission.Modified)
+}
+
+// resourcepermissionResource is the resource implementation.
+type resourcepermissionResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *resourcepermissionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *resourcepermissionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_permission"
+}
+
+// Schema defines the schema for the resource.
+func (r *resourcepermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource Permission Resource that grants a ProVision Group access to a Resource and, unless recursive is false, to all of its descendants",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the Permission.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "Numeric identifier of the Resource the access is granted on",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Numeric identifier of the Group the access is granted to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access": schema.StringAttribute{
+				Description: "Access granted to the Group, it can be view, edit or admin",
+				Required:    true,
+				Validators: []validator.String{
+					stringOneOf(permissionAccessView, permissionAccessEdit, permissionAccessAdmin),
+				},
+			},
+			"recursive": schema.BoolAttribute{
+				Description: "Grant the access on the descendants of the Resource too, defaults to true",
+				Optional:    true,
+				Computed:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "Date and Time of the last modification",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource
+func (r *resourcepermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan resourcepermissionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new permission
+	permission, err := addResourcePermission(r.client, planToResourcePermission(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new ProVision Resource Permission",
+			"Could not grant ProVision Group ID "+plan.GroupID.ValueString()+" access to Resource ID "+plan.ResourceID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resourcepermissionToState(&plan, permission)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourcepermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Read resource information
+func (r *resourcepermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state resourcepermissionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, err := getResourcePermissionByID(r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision Resource Permission",
+			"Could not read ProVision Resource Permission ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(permissions) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision Resource Permission",
+			"ProVision Resource Permission has not been found ID "+state.ID.ValueString(),
+		)
+		return
+	}
+
+	resourcepermissionToState(&state, &permissions[0])
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *resourcepermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan resourcepermissionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating Resource Permission ID "+plan.ID.ValueString())
+
+	// Update existing permission
+	permission, err := updateResourcePermission(r.client, planToResourcePermission(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating ProVision Resource Permission",
+			"Could not update ProVision Resource Permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resourcepermissionToState(&plan, permission)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *resourcepermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state resourcepermissionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Revoke existing permission
+	err := deleteResourcePermissionByID(r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision Resource Permission",
+			"Could not revoke ProVision Resource Permission, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
@@ -2,17 +2,27 @@ package provision6connect
 
 import (
 	"context"
+	"strconv"
 
 	provisionclient "github.com/6connect/golangclient"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // resourcesDataSourceModel maps the data source schema data.
 type resourcesDataSourceModel struct {
-	Search    map[string]string `tfsdk:"search"`
-	Resources []resourcesModel  `tfsdk:"resources"`
+	Search     map[string]string `tfsdk:"search"`
+	Name       types.String      `tfsdk:"name"`
+	Type       types.String      `tfsdk:"type"`
+	ParentID   types.String      `tfsdk:"parent_id"`
+	Slug       types.String      `tfsdk:"slug"`
+	Attrs      map[string]string `tfsdk:"attrs"`
+	Limit      types.Int64       `tfsdk:"limit"`
+	Offset     types.Int64       `tfsdk:"offset"`
+	MaxResults types.Int64       `tfsdk:"max_results"`
+	Resources  []resourcesModel  `tfsdk:"resources"`
 }
 
 // resourcesModel maps resources schema data.
@@ -67,6 +77,39 @@ func (d *resourcesDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 				MarkdownDescription: "The map will be used into the API request to retrieve resource data",
 				Optional:            true,
 			},
+			"name": schema.StringAttribute{
+				Description: "Only return Resources with this name",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return Resources of this type",
+				Optional:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Only return the children of this Resource",
+				Optional:    true,
+			},
+			"slug": schema.StringAttribute{
+				Description: "Only return the Resource with this slug",
+				Optional:    true,
+			},
+			"attrs": schema.MapAttribute{
+				Description: "Only return Resources whose attributes have these values, checked on the Resources returned by ProVision so it applies after limit and offset",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of Resources returned by ProVision, only this page is read when it is set. Every page is read otherwise",
+				Optional:    true,
+			},
+			"offset": schema.Int64Attribute{
+				Description: "Number of Resources skipped by ProVision before the first one returned, used with limit to read the results page by page",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "Maximum number of Resources read when every page is read, defaults to 10000. A warning is reported when more Resources match",
+				Optional:    true,
+			},
 			"resources": schema.ListNestedAttribute{
 				Description: "Resource List of the resource found by the search query",
 				Computed:    true,
@@ -117,11 +160,89 @@ func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, ok := state.Search["load_attributes"]
+
+	// The search map is copied as it is optional and stays as configured in state
+	filters := map[string]string{}
+	for key, value := range state.Search {
+		filters[key] = value
+	}
+	_, ok := filters["load_attributes"]
 	if !ok {
-		state.Search["load_attributes"] = "1"
+		filters["load_attributes"] = "1"
+	}
+
+	for _, filter := range []struct {
+		name  string
+		value types.String
+	}{
+		{"name", state.Name},
+		{"type", state.Type},
+		{"parent_id", state.ParentID},
+		{"slug", state.Slug},
+	} {
+		if filter.value.IsNull() {
+			continue
+		}
+		if _, ok := state.Search[filter.name]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(filter.name),
+				"Conflicting Resources Filter",
+				filter.name+" is also set in search, set it only once.",
+			)
+			continue
+		}
+		filters[filter.name] = filter.value.ValueString()
+	}
+
+	for _, page := range []struct {
+		name  string
+		value types.Int64
+		min   int64
+	}{
+		{"limit", state.Limit, 1},
+		{"offset", state.Offset, 0},
+		{"max_results", state.MaxResults, 1},
+	} {
+		if page.value.IsNull() {
+			continue
+		}
+		if page.value.ValueInt64() < page.min {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(page.name),
+				"Invalid Resources Pagination",
+				page.name+" must be at least "+strconv.FormatInt(page.min, 10)+".",
+			)
+			continue
+		}
+		if page.name != "max_results" {
+			filters[page.name] = strconv.FormatInt(page.value.ValueInt64(), 10)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxResults := listDefaultMaxResults
+	if !state.MaxResults.IsNull() {
+		maxResults = int(state.MaxResults.ValueInt64())
+	}
+
+	// A configured limit reads that page only, every page is read otherwise
+	var resources []provisionclient.Resource
+	var truncated bool
+	var err error
+	if _, ok := filters["limit"]; ok {
+		resources, err = d.client.Resources.GetResources(&filters)
+	} else {
+		offset, _ := strconv.Atoi(filters["offset"])
+		resources, truncated, err = fetchAllPages(func(offset, limit int) ([]provisionclient.Resource, error) {
+			page := pageFilters(filters, offset, limit)
+			return d.client.Resources.GetResources(&page)
+		}, func(resource provisionclient.Resource) string {
+			return string(resource.ID)
+		}, offset, maxResults)
 	}
-	resources, err := d.client.Resources.GetResources(&state.Search)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -131,8 +252,18 @@ func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	if truncated {
+		resp.Diagnostics.AddWarning(
+			"ProVision Resources Truncated",
+			"Only the first "+strconv.Itoa(maxResults)+" Resources have been read, more match the search. Narrow the search or raise max_results.",
+		)
+	}
+
 	// Map response body to model
 	for _, resource := range resources {
+		if !resourceAttrsMatch(resource.Attrs, state.Attrs) {
+			continue
+		}
 		/*
 			attrMap := make(map[string]attr.Value, len(resource.Attrs))
 			for key, val := range resource.Attrs {
@@ -161,3 +292,13 @@ func (d *resourcesDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 }
+
+// resourceAttrsMatch reports whether the attributes hold every wanted value.
+func resourceAttrsMatch(attrs, wanted map[string]string) bool {
+	for key, value := range wanted {
+		if current, ok := attrs[key]; !ok || current != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,232 @@
+package provision6connect
+
+import (
+	"context"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// resourcetreeDataSourceModel maps the data source schema data.
+type resourcetreeDataSourceModel struct {
+	RootID           types.String        `tfsdk:"root_id"`
+	MaxDepth         types.Int64         `tfsdk:"max_depth"`
+	IncludeNetblocks types.Bool          `tfsdk:"include_netblocks"`
+	Nodes            []resourcetreeModel `tfsdk:"nodes"`
+}
+
+// resourcetreeModel maps resource tree node schema data.
+type resourcetreeModel struct {
+	ID        types.String                 `tfsdk:"id"`
+	ParentID  types.String                 `tfsdk:"parent_id"`
+	Name      types.String                 `tfsdk:"name"`
+	Slug      types.String                 `tfsdk:"slug"`
+	Type      types.String                 `tfsdk:"type"`
+	Depth     types.Int64                  `tfsdk:"depth"`
+	Path      []string                     `tfsdk:"path"`
+	Attrs     map[string]string            `tfsdk:"attrs"`
+	Netblocks []resourcetreeNetblocksModel `tfsdk:"netblocks"`
+}
+
+// resourcetreeNetblocksModel maps the netblocks assigned to a resource tree node.
+type resourcetreeNetblocksModel struct {
+	ID   types.String `tfsdk:"id"`
+	CIDR types.String `tfsdk:"cidr"`
+	Type types.String `tfsdk:"type"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &resourcetreeDataSource{}
+	_ datasource.DataSourceWithConfigure = &resourcetreeDataSource{}
+)
+
+// NewResourcetreeDataSource is a helper function to simplify the provider implementation.
+func NewResourcetreeDataSource() datasource.DataSource {
+	return &resourcetreeDataSource{}
+}
+
+// resourcetreeDataSource is the data source implementation.
+type resourcetreeDataSource struct {
+	client *provisionclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *resourcetreeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource_tree"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *resourcetreeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *resourcetreeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resource Tree Data Source returning a ProVision Resource and all of its descendants by following the parent_id links. " +
+			"Nodes are listed depth first, the root first and the children of each node sorted by name.",
+		Attributes: map[string]schema.Attribute{
+			"root_id": schema.StringAttribute{
+				Description: "Numeric identifier of the Resource at the root of the tree",
+				Required:    true,
+			},
+			"max_depth": schema.Int64Attribute{
+				Description: "Number of levels walked below the root, 0 only returns the root. The whole tree is walked when not set",
+				Optional:    true,
+			},
+			"include_netblocks": schema.BoolAttribute{
+				Description: "Return the netblocks assigned to each node, defaults to false",
+				Optional:    true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "Resources of the tree, the root included",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the Resource",
+							Computed:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "Parent Resource identifier Number",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Resource Name",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "Resource Slug",
+							Computed:    true,
+						},
+						"type": schema.StringAttribute{
+							Description: "Resource Type",
+							Computed:    true,
+						},
+						"depth": schema.Int64Attribute{
+							Description: "Number of levels between the root and the Resource, 0 for the root",
+							Computed:    true,
+						},
+						"path": schema.ListAttribute{
+							Description: "Identifiers of the Resources from the root down to the Resource, both included",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"attrs": schema.MapAttribute{
+							Description: "Resource Attributes List",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"netblocks": schema.ListNestedAttribute{
+							Description: "Netblocks assigned to the Resource, only set when include_netblocks is true",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Description: "Numeric identifier of the NetBlock",
+										Computed:    true,
+									},
+									"cidr": schema.StringAttribute{
+										Description: "CIDR of the netblock",
+										Computed:    true,
+									},
+									"type": schema.StringAttribute{
+										Description: "IP Type can be either ipv4 or ipv6",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *resourcetreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state resourcetreeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxDepth := -1
+	if !state.MaxDepth.IsNull() {
+		if state.MaxDepth.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_depth"),
+				"Invalid Resource Tree Depth",
+				"max_depth can not be negative.",
+			)
+			return
+		}
+		maxDepth = int(state.MaxDepth.ValueInt64())
+	}
+
+	nodes, err := walkResourceTree(&d.client.Resources, state.RootID.ValueString(), maxDepth)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision Resource Tree",
+			err.Error(),
+		)
+		return
+	}
+
+	includeNetblocks := state.IncludeNetblocks.ValueBool()
+
+	// Map response body to model
+	state.Nodes = []resourcetreeModel{}
+	for _, node := range nodes {
+		nodeState := resourcetreeModel{
+			ID:       types.StringValue(string(node.Resource.ID)),
+			ParentID: types.StringValue(string(node.Resource.ParentID)),
+			Name:     types.StringValue(node.Resource.Name),
+			Slug:     types.StringValue(node.Resource.Slug),
+			Type:     types.StringValue(node.Resource.Type),
+			Depth:    types.Int64Value(int64(node.Depth)),
+			Path:     node.Path,
+			Attrs:    node.Resource.Attrs,
+		}
+
+		if includeNetblocks {
+			netblocks, err := getResourceNetblocks(d.client, string(node.Resource.ID))
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Unable to Read ProVision Netblocks",
+					"Could not read the netblocks of ProVision Resource ID "+string(node.Resource.ID)+": "+err.Error(),
+				)
+				return
+			}
+
+			nodeState.Netblocks = []resourcetreeNetblocksModel{}
+			for _, netblock := range netblocks {
+				nodeState.Netblocks = append(nodeState.Netblocks, resourcetreeNetblocksModel{
+					ID:   types.StringValue(string(netblock.ID)),
+					CIDR: types.StringValue(netblock.CIDR),
+					Type: types.StringValue(netblock.Type),
+				})
+			}
+		}
+
+		state.Nodes = append(state.Nodes, nodeState)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,186 @@
+package provision6connect
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// reverseZoneNames derives the reverse zone names that cover a prefix.
+//
+// IPv4 prefixes are rounded to the next octet boundary, a /20 becomes sixteen
+// /24 zones. Prefixes longer than /24 use RFC 2317 classless delegation and
+// produce a single zone named <first address>-<prefix length>, for example
+// 64-26.2.0.192.in-addr.arpa. for 192.0.2.64/26.
+//
+// IPv6 prefixes are rounded to the next nibble boundary, a /46 becomes four
+// /48 zones.
+func reverseZoneNames(prefix netip.Prefix) ([]string, error) {
+	prefix = prefix.Masked()
+	bits := prefix.Bits()
+
+	if prefix.Addr().Is4() {
+		if bits < 1 {
+			return nil, fmt.Errorf("prefix %s is too short for a reverse zone", prefix)
+		}
+
+		octets := prefix.Addr().As4()
+		if bits > 24 {
+			return []string{
+				strconv.Itoa(int(octets[3])) + "-" + strconv.Itoa(bits) + "." + ipv4ReverseLabels(octets[:3]) + "in-addr.arpa.",
+			}, nil
+		}
+
+		boundary := (bits + 7) / 8 * 8
+		count := 1 << (boundary - bits)
+
+		base := uint32(octets[0])<<24 | uint32(octets[1])<<16 | uint32(octets[2])<<8 | uint32(octets[3])
+		names := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			network := base + uint32(i)<<(32-boundary)
+			address := [4]byte{byte(network >> 24), byte(network >> 16), byte(network >> 8), byte(network)}
+			names = append(names, ipv4ReverseLabels(address[:boundary/8])+"in-addr.arpa.")
+		}
+		return names, nil
+	}
+
+	if bits < 1 {
+		return nil, fmt.Errorf("prefix %s is too short for a reverse zone", prefix)
+	}
+
+	boundary := (bits + 3) / 4 * 4
+	count := 1 << (boundary - bits)
+
+	address := prefix.Addr().As16()
+	base := new(big.Int).SetBytes(address[:])
+	names := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		network := new(big.Int).Lsh(big.NewInt(int64(i)), uint(128-boundary))
+		network.Add(network, base)
+
+		var raw [16]byte
+		network.FillBytes(raw[:])
+		names = append(names, ipv6ReverseLabels(raw, boundary/4)+"ip6.arpa.")
+	}
+	return names, nil
+}
+
+// ipv4ReverseLabels returns the octets in reverse order, each followed by a dot.
+func ipv4ReverseLabels(octets []byte) string {
+	var b strings.Builder
+	for i := len(octets) - 1; i >= 0; i-- {
+		b.WriteString(strconv.Itoa(int(octets[i])) + ".")
+	}
+	return b.String()
+}
+
+// ipv6ReverseLabels returns the first nibbles of the address in reverse order,
+// each followed by a dot.
+func ipv6ReverseLabels(address [16]byte, nibbles int) string {
+	const digits = "0123456789abcdef"
+
+	var b strings.Builder
+	for i := nibbles - 1; i >= 0; i-- {
+		value := address[i/2]
+		if i%2 == 0 {
+			value >>= 4
+		}
+		b.WriteByte(digits[value&0x0f])
+		b.WriteByte('.')
+	}
+	return b.String()
+}
+
+// reverseName returns the full in-addr.arpa. or ip6.arpa. name of an address.
+func reverseName(ip netip.Addr) string {
+	if ip.Is4() {
+		octets := ip.As4()
+		return ipv4ReverseLabels(octets[:]) + "in-addr.arpa."
+	}
+	return ipv6ReverseLabels(ip.As16(), 32) + "ip6.arpa."
+}
+
+// reverseZoneHost returns the PTR record host of the address inside the reverse
+// zone, it reports false when the zone does not cover the address. RFC 2317
+// classless zones named <first>-<prefix length> or <first>/<prefix length> are
+// matched against the range they delegate.
+func reverseZoneHost(zoneName string, ip netip.Addr) (string, bool) {
+	ip = ip.Unmap()
+	name := canonicalFQDN(zoneName)
+	full := reverseName(ip)
+
+	label, rest, _ := strings.Cut(name, ".")
+	if ip.Is4() && strings.ContainsAny(label, "-/") {
+		first, bits, ok := strings.Cut(strings.Replace(label, "/", "-", 1), "-")
+		start, err := strconv.Atoi(first)
+		if !ok || err != nil {
+			return "", false
+		}
+		length, err := strconv.Atoi(bits)
+		if err != nil || length < 25 || length > 32 {
+			return "", false
+		}
+
+		octet, parent, _ := strings.Cut(full, ".")
+		last, _ := strconv.Atoi(octet)
+		if parent != rest || last < start || last >= start+1<<(32-length) {
+			return "", false
+		}
+		return octet + "." + name, true
+	}
+
+	if full == name || strings.HasSuffix(full, "."+name) {
+		return full, true
+	}
+	return "", false
+}
+
+// findReverseZone returns the most specific reverse zone covering the address
+// together with the PTR record host inside it.
+func findReverseZone(dns *provisionclient.DNSMethods, ip netip.Addr) (*provisionclient.DNSZone, string, error) {
+	zones, _, err := fetchAllPages(func(offset, limit int) ([]provisionclient.DNSZone, error) {
+		page := pageFilters(nil, offset, limit)
+		return dns.GetZones(&page)
+	}, func(zone provisionclient.DNSZone) string {
+		return string(zone.ID)
+	}, 0, math.MaxInt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var matches []provisionclient.DNSZone
+	var host string
+	for _, zone := range zones {
+		zoneHost, ok := reverseZoneHost(zone.Name, ip)
+		if !ok {
+			continue
+		}
+		if len(matches) != 0 && len(canonicalFQDN(zone.Name)) < len(canonicalFQDN(matches[0].Name)) {
+			continue
+		}
+		if len(matches) != 0 && len(canonicalFQDN(zone.Name)) > len(canonicalFQDN(matches[0].Name)) {
+			matches = nil
+		}
+		matches = append(matches, zone)
+		host = zoneHost
+	}
+
+	if len(matches) == 0 {
+		return nil, "", fmt.Errorf("no reverse DNS Zone covering %s has been found, create it first, for example with provision6connect_reverse_zone", ip)
+	}
+
+	if len(matches) > 1 {
+		groups := make([]string, 0, len(matches))
+		for _, zone := range matches {
+			groups = append(groups, string(zone.GroupID))
+		}
+		return nil, "", fmt.Errorf("the reverse DNS Zone %s exists in several groups (%s), set ptr_zone_id to select one of them", matches[0].Name, strings.Join(groups, ", "))
+	}
+
+	return &matches[0], host, nil
+}
@@ -0,0 +1,532 @@
+package provision6connect
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &reversezoneResource{}
+	_ resource.ResourceWithConfigure      = &reversezoneResource{}
+	_ resource.ResourceWithImportState    = &reversezoneResource{}
+	_ resource.ResourceWithValidateConfig = &reversezoneResource{}
+)
+
+// NewReversezoneResource is a helper function to simplify the provider implementation.
+func NewReversezoneResource() resource.Resource {
+	return &reversezoneResource{}
+}
+
+// reversezoneModel maps reverse zone schema data.
+type reversezoneModel struct {
+	ID         types.String `tfsdk:"id"`
+	NetblockID types.String `tfsdk:"netblock_id"`
+	CIDR       types.String `tfsdk:"cidr"`
+	GroupID    types.String `tfsdk:"group_id"`
+	ParentID   types.String `tfsdk:"parent_id"`
+	ZoneHost   types.String `tfsdk:"zone_host"`
+	ZoneMail   types.String `tfsdk:"zone_mail"`
+	Zones      types.List   `tfsdk:"zones"`
+}
+
+// reversezoneZoneModel maps the zones created for the prefix.
+type reversezoneZoneModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+var reversezoneZoneType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":   types.StringType,
+		"name": types.StringType,
+	},
+}
+
+// reversezoneResource is the resource implementation.
+type reversezoneResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *reversezoneResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *reversezoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reverse_zone"
+}
+
+// Schema defines the schema for the resource.
+func (r *reversezoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reverse Zone Resource that creates the in-addr.arpa. or ip6.arpa. DNS Zones covering a netblock. " +
+			"IPv4 prefixes are split on octet boundaries and prefixes longer than /24 use RFC 2317 classless delegation, IPv6 prefixes are split on nibble boundaries. " +
+			"Either netblock_id or cidr must be specified. " +
+			"It can be imported with the prefix, or with netblock:<netblock_id> when it is configured with netblock_id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Network address of the prefix covered by the reverse zones.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"netblock_id": schema.StringAttribute{
+				Description: "Numeric identifier of the ProVision Netblock to create the reverse zones for",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cidr": schema.StringAttribute{
+				Description: "Prefix to create the reverse zones for in CIDR format Ex: 192.0.2.0/24 or 2001:db8::/48. It is set from the netblock when netblock_id is used.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Description: "Group Identifier for the reverse zones",
+				Optional:    true,
+				Computed:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Parent ID for the reverse zones mainly because of permissions, the recursive provision6connect_resource_permission granted on the parent apply to it. If it is not set ProVision will set TLR by default.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"zone_host": schema.StringAttribute{
+				Description: "DNS Zone Host in FQDN format used for every reverse zone",
+				Optional:    true,
+				Computed:    true,
+			},
+			"zone_mail": schema.StringAttribute{
+				Description: "DNS Zone Mail in FQDN format used for every reverse zone",
+				Optional:    true,
+				Computed:    true,
+			},
+			"zones": schema.ListNestedAttribute{
+				Description: "Reverse DNS Zones created for the prefix",
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the DNS Zone",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "DNS Zone Name in FQDN format",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig makes sure exactly one of netblock_id and cidr is set and the prefix can be delegated.
+func (r *reversezoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config reversezoneModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.NetblockID.IsNull() && !config.CIDR.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cidr"),
+			"Conflicting Reverse Zone Configuration",
+			"Only one of netblock_id or cidr can be set.",
+		)
+		return
+	}
+
+	if config.NetblockID.IsNull() && config.CIDR.IsNull() {
+		resp.Diagnostics.AddError(
+			"Either netblock_id or cidr are required",
+			"Either netblock_id or cidr are required",
+		)
+		return
+	}
+
+	if config.CIDR.IsNull() || config.CIDR.IsUnknown() {
+		return
+	}
+
+	prefix, err := netip.ParsePrefix(config.CIDR.ValueString())
+	if err == nil {
+		_, err = reverseZoneNames(prefix)
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cidr"),
+			"Invalid Reverse Zone Prefix",
+			err.Error(),
+		)
+	}
+}
+
+// prefix returns the prefix of the reverse zones, reading the netblock CIDR from ProVision when needed.
+func (r *reversezoneResource) prefix(plan reversezoneModel) (netip.Prefix, string, error) {
+	cidr := plan.CIDR.ValueString()
+	if plan.CIDR.IsNull() || plan.CIDR.IsUnknown() {
+		netblock, err := r.client.IPAM.GetNetblockByID(plan.NetblockID.ValueString())
+		if err != nil {
+			return netip.Prefix{}, "", err
+		}
+		cidr = netblock.CIDR
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return netip.Prefix{}, "", err
+	}
+
+	return prefix.Masked(), cidr, nil
+}
+
+// reversezoneToState sets the zones and the settings shared by them into the state.
+func reversezoneToState(ctx context.Context, state *reversezoneModel, zones []provisionclient.DNSZone) diag.Diagnostics {
+	models := make([]reversezoneZoneModel, 0, len(zones))
+	for _, zone := range zones {
+		models = append(models, reversezoneZoneModel{
+			ID:   types.StringValue(string(zone.ID)),
+			Name: types.StringValue(zone.Name),
+		})
+	}
+
+	if len(zones) != 0 {
+		state.ParentID = types.StringValue(string(zones[0].ParentID))
+		if zones[0].GroupID != "" || state.GroupID.IsUnknown() {
+			state.GroupID = types.StringValue(string(zones[0].GroupID))
+		}
+		state.ZoneHost = types.StringValue(zones[0].ZoneHost)
+		state.ZoneMail = types.StringValue(zones[0].ZoneMail)
+	}
+
+	var diags diag.Diagnostics
+	state.Zones, diags = types.ListValueFrom(ctx, reversezoneZoneType, models)
+	return diags
+}
+
+// Create a new resource
+func (r *reversezoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan reversezoneModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix, cidr, err := r.prefix(plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision Netblock",
+			"Could not read the prefix of ProVision Netblock ID "+plan.NetblockID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	names, err := reverseZoneNames(prefix)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Reverse Zone Prefix",
+			err.Error(),
+		)
+		return
+	}
+
+	// Create new zones, the ones already created are removed again when one of them fails
+	zones := make([]provisionclient.DNSZone, 0, len(names))
+	for _, name := range names {
+		newZone := provisionclient.DNSZone{
+			Name:     name,
+			ZoneType: "r",
+		}
+		if !plan.GroupID.IsUnknown() {
+			newZone.GroupID = provisionclient.PVID(plan.GroupID.ValueString())
+		}
+		if !plan.ParentID.IsUnknown() {
+			newZone.ParentID = provisionclient.PVID(plan.ParentID.ValueString())
+		}
+		if !plan.ZoneHost.IsUnknown() {
+			newZone.ZoneHost = plan.ZoneHost.ValueString()
+		}
+		if !plan.ZoneMail.IsUnknown() {
+			newZone.ZoneMail = plan.ZoneMail.ValueString()
+		}
+
+		dnszone, err := r.client.DNS.AddZone(newZone)
+		if err != nil {
+			for _, created := range zones {
+				if err := r.client.DNS.DeleteZoneByID(string(created.ID)); err != nil {
+					tflog.Warn(ctx, "Could not remove reverse DNS Zone "+created.Name+": "+err.Error())
+				}
+			}
+			resp.Diagnostics.AddError(
+				"Error creating new ProVision Reverse Zone",
+				"Could not create ProVision DNS Zone "+name+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		zones = append(zones, *dnszone)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(prefix.String())
+	plan.CIDR = types.StringValue(cidr)
+	resp.Diagnostics.Append(reversezoneToState(ctx, &plan, zones)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *reversezoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// netblock:<id> is saved to netblock_id, the prefix is read from the netblock on Read
+	if strings.HasPrefix(req.ID, "netblock:") {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("netblock_id"), strings.TrimPrefix(req.ID, "netblock:"))...)
+		return
+	}
+
+	// Retrieve import CIDR and save to id and cidr attributes, the zones are found by name on Read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cidr"), req.ID)...)
+}
+
+// Read resource information
+func (r *reversezoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state reversezoneModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []reversezoneZoneModel
+	if !state.Zones.IsNull() && !state.Zones.IsUnknown() {
+		resp.Diagnostics.Append(state.Zones.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var zones []provisionclient.DNSZone
+
+	if len(current) == 0 {
+		// Imported resources only know the prefix or the netblock
+		lookup := state.CIDR.ValueString()
+		if state.CIDR.IsNull() {
+			lookup = "ProVision Netblock ID " + state.NetblockID.ValueString()
+		}
+		prefix, cidr, err := r.prefix(state)
+		if err == nil {
+			state.ID = types.StringValue(prefix.String())
+			state.CIDR = types.StringValue(cidr)
+			current, err = r.findZones(prefix, state.GroupID.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading ProVision Reverse Zone",
+				"Could not find the reverse DNS Zones of "+lookup+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	for _, zone := range current {
+		found, err := r.client.DNS.GetZoneByID(zone.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading ProVision Reverse Zone",
+				"Could not read ProVision DNS Zone ID "+zone.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		if len(found) == 0 {
+			resp.Diagnostics.AddError(
+				"Error Finding ProVision Reverse Zone",
+				"ProVision DNS Zone "+zone.Name.ValueString()+" has not been found ID "+zone.ID.ValueString(),
+			)
+			return
+		}
+		zones = append(zones, found[0])
+	}
+
+	resp.Diagnostics.Append(reversezoneToState(ctx, &state, zones)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// findZones looks up the existing reverse zones of a prefix by name.
+func (r *reversezoneResource) findZones(prefix netip.Prefix, groupID string) ([]reversezoneZoneModel, error) {
+	names, err := reverseZoneNames(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	zones := make([]reversezoneZoneModel, 0, len(names))
+	for _, name := range names {
+		found, err := findDNSZonesByName(&r.client.DNS, name, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) == 0 {
+			return nil, fmt.Errorf("DNS Zone %s has not been found", name)
+		}
+		if len(found) > 1 {
+			groups := make([]string, 0, len(found))
+			for _, zone := range found {
+				groups = append(groups, string(zone.GroupID))
+			}
+			return nil, fmt.Errorf("DNS Zone %s exists in several groups (%s)", name, strings.Join(groups, ", "))
+		}
+
+		zones = append(zones, reversezoneZoneModel{
+			ID:   types.StringValue(string(found[0].ID)),
+			Name: types.StringValue(found[0].Name),
+		})
+	}
+
+	return zones, nil
+}
+
+func (r *reversezoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan reversezoneModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating Reverse Zone "+plan.ID.ValueString())
+
+	var current []reversezoneZoneModel
+	resp.Diagnostics.Append(plan.Zones.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing zones, the zone settings not managed here are kept as they are
+	zones := make([]provisionclient.DNSZone, 0, len(current))
+	for _, zone := range current {
+		found, err := r.client.DNS.GetZoneByID(zone.ID.ValueString())
+		if err == nil && len(found) == 0 {
+			err = fmt.Errorf("DNS Zone ID %s has not been found", zone.ID.ValueString())
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating ProVision Reverse Zone",
+				"Could not read ProVision DNS Zone "+zone.Name.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+		newZone := found[0]
+		if !plan.GroupID.IsUnknown() {
+			newZone.GroupID = provisionclient.PVID(plan.GroupID.ValueString())
+		}
+		if !plan.ParentID.IsUnknown() {
+			newZone.ParentID = provisionclient.PVID(plan.ParentID.ValueString())
+		}
+		if !plan.ZoneHost.IsUnknown() {
+			newZone.ZoneHost = plan.ZoneHost.ValueString()
+		}
+		if !plan.ZoneMail.IsUnknown() {
+			newZone.ZoneMail = plan.ZoneMail.ValueString()
+		}
+
+		dnszone, err := r.client.DNS.UpdateZone(newZone)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating ProVision Reverse Zone",
+				"Could not update ProVision DNS Zone "+zone.Name.ValueString()+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+		zones = append(zones, *dnszone)
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(reversezoneToState(ctx, &plan, zones)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *reversezoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state reversezoneModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var zones []reversezoneZoneModel
+	resp.Diagnostics.Append(state.Zones.ElementsAs(ctx, &zones, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing zones
+	for _, zone := range zones {
+		err := r.client.DNS.DeleteZoneByID(zone.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Deleting ProVision Reverse Zone",
+				"Could not delete ProVision DNS Zone "+zone.Name.ValueString()+", unexpected error: "+err.Error(),
+			)
+		}
+	}
+}
@@ -0,0 +1,306 @@
+package provision6connect
+
+import (
+	"context"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &userResource{}
+	_ resource.ResourceWithConfigure   = &userResource{}
+	_ resource.ResourceWithImportState = &userResource{}
+)
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userModel maps user schema data.
+type userModel struct {
+	ID        types.String `tfsdk:"id"`
+	Username  types.String `tfsdk:"username"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Password  types.String `tfsdk:"password"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	GroupIDs  types.Set    `tfsdk:"group_ids"`
+	Modified  types.String `tfsdk:"modified"`
+}
+
+func (r *userResource) planToUser(ctx context.Context, plan userModel) (pvUser, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	user := pvUser{
+		ID:        provisionclient.PVID(plan.ID.ValueString()),
+		Username:  plan.Username.ValueString(),
+		Email:     plan.Email.ValueString(),
+		FirstName: plan.FirstName.ValueString(),
+		LastName:  plan.LastName.ValueString(),
+		Password:  plan.Password.ValueString(),
+	}
+	if !plan.Enabled.IsUnknown() {
+		enabled := plan.Enabled.ValueBool()
+		user.Enabled = &enabled
+	}
+
+	// Memberships are only sent when they are managed from the user.
+	if !plan.GroupIDs.IsUnknown() {
+		groupIDs, d := pvidSetElements(ctx, plan.GroupIDs)
+		diags.Append(d...)
+		user.GroupIDs = &groupIDs
+	}
+
+	return user, diags
+}
+
+func userToState(ctx context.Context, state *userModel, user *pvUser) diag.Diagnostics {
+	state.ID = types.StringValue(string(user.ID))
+	state.Username = types.StringValue(user.Username)
+	state.Email = types.StringValue(user.Email)
+	state.FirstName = optionalStringValue(state.FirstName, user.FirstName)
+	state.LastName = optionalStringValue(state.LastName, user.LastName)
+	state.Modified = types.StringValue(user.Modified)
+
+	state.Enabled = types.BoolValue(user.Enabled == nil || *user.Enabled)
+
+	var groupIDs []provisionclient.PVID
+	if user.GroupIDs != nil {
+		groupIDs = *user.GroupIDs
+	}
+
+	var diags diag.Diagnostics
+	state.GroupIDs, diags = pvidSetValue(ctx, groupIDs)
+	return diags
+}
+
+// userResource is the resource implementation.
+type userResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "User Resource that represents a ProVision user and the groups it is a member of",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the User.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: "Login name of the User, changing it creates a new User",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the User",
+				Required:    true,
+			},
+			"first_name": schema.StringAttribute{
+				Description: "First name of the User",
+				Optional:    true,
+			},
+			"last_name": schema.StringAttribute{
+				Description: "Last name of the User",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the User, ProVision never returns it so changes made outside of Terraform are not detected",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Allow the User to log in, ProVision enables new Users by default",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_ids": schema.SetAttribute{
+				Description: "Group IDs the User is a member of",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"modified": schema.StringAttribute{
+				Description: "Date and Time of the last modification",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan userModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newUser, diags := r.planToUser(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new user
+	user, err := addUser(r.client, newUser)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new ProVision User",
+			"Could not create ProVision User, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(userToState(ctx, &plan, user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// Read resource information
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state userModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := getUserByID(r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision User",
+			"Could not read ProVision User ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(users) == 0 {
+		resp.Diagnostics.AddError(
+			"Error Finding ProVision User",
+			"ProVision User has not been found ID "+state.ID.ValueString(),
+		)
+		return
+	}
+
+	// The password is never returned by ProVision, the state value is kept.
+	resp.Diagnostics.Append(userToState(ctx, &state, &users[0])...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan userModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating User ID "+plan.ID.ValueString())
+
+	newUser, diags := r.planToUser(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing user
+	user, err := updateUser(r.client, newUser)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating ProVision User",
+			"Could not update ProVision User, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(userToState(ctx, &plan, user)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state userModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing user
+	err := deleteUserByID(r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision User",
+			"Could not delete ProVision User, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
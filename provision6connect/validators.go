@@ -0,0 +1,87 @@
+package provision6connect
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// stringOneOfValidator checks that a string attribute holds one of the accepted values.
+type stringOneOfValidator struct {
+	values []string
+}
+
+// stringOneOf returns a validator which ensures the configured value is one of values.
+func stringOneOf(values ...string) validator.String {
+	return stringOneOfValidator{values: values}
+}
+
+// Description describes the validation in plain text formatting.
+func (v stringOneOfValidator) Description(_ context.Context) string {
+	return "value must be one of: " + strings.Join(v.values, ", ")
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v stringOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v stringOneOfValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, value := range v.values {
+		if req.ConfigValue.ValueString() == value {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		"Attribute "+req.Path.String()+" "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+	)
+}
+
+// resourceSlugPattern matches the slugs accepted by ProVision, lowercase letters and
+// digits separated by single dashes or underscores.
+var resourceSlugPattern = regexp.MustCompile(`^[a-z0-9]+(?:[-_][a-z0-9]+)*$`)
+
+// resourceSlugValidator checks that a string attribute holds a valid resource slug.
+type resourceSlugValidator struct{}
+
+// resourceSlug returns a validator which ensures the configured value is a valid slug.
+func resourceSlug() validator.String {
+	return resourceSlugValidator{}
+}
+
+// Description describes the validation in plain text formatting.
+func (v resourceSlugValidator) Description(_ context.Context) string {
+	return "value must be lowercase letters and digits separated by single - or _"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v resourceSlugValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v resourceSlugValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if resourceSlugPattern.MatchString(req.ConfigValue.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Attribute Value",
+		"Attribute "+req.Path.String()+" "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+	)
+}
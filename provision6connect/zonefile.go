@@ -0,0 +1,407 @@
+package provision6connect
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+// zoneFileLine is a logical line of a zone file, parentheses may join several
+// physical lines into one.
+type zoneFileLine struct {
+	number  int
+	blank   bool
+	tokens  []string
+	hasText bool
+}
+
+// splitZoneFile splits zone file content into logical lines of tokens. Comments
+// are removed and quoted strings are kept as single tokens including their quotes.
+func splitZoneFile(content string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var token strings.Builder
+
+	line := zoneFileLine{number: 1}
+	number := 1
+	depth := 0
+	quoted := false
+	comment := false
+	startOfLine := true
+
+	flushToken := func() {
+		if token.Len() != 0 {
+			line.tokens = append(line.tokens, token.String())
+			line.hasText = true
+			token.Reset()
+		}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if comment {
+			if c != '\n' {
+				continue
+			}
+			comment = false
+		}
+
+		if quoted {
+			token.WriteByte(c)
+			switch c {
+			case '\\':
+				if i+1 < len(content) {
+					i++
+					token.WriteByte(content[i])
+				}
+			case '"':
+				quoted = false
+			case '\n':
+				number++
+			}
+			continue
+		}
+
+		if startOfLine && depth == 0 {
+			line.blank = c == ' ' || c == '\t'
+			startOfLine = false
+		}
+
+		switch c {
+		case '"':
+			quoted = true
+			token.WriteByte(c)
+		case ';':
+			flushToken()
+			comment = true
+		case '(':
+			flushToken()
+			depth++
+		case ')':
+			flushToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected )", number)
+			}
+			depth--
+		case '\n':
+			flushToken()
+			number++
+			if depth == 0 {
+				if line.hasText {
+					lines = append(lines, line)
+				}
+				line = zoneFileLine{number: number}
+				startOfLine = true
+			}
+		case ' ', '\t', '\r':
+			flushToken()
+		case '\\':
+			token.WriteByte(c)
+			if i+1 < len(content) {
+				i++
+				token.WriteByte(content[i])
+			}
+		default:
+			token.WriteByte(c)
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", line.number)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unterminated (", line.number)
+	}
+
+	flushToken()
+	if line.hasText {
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// parseZoneTTL parses a TTL in seconds or with BIND units, for Example: 1h30m.
+func parseZoneTTL(value string) (int, bool) {
+	if value == "" || !unicode.IsDigit(rune(value[0])) {
+		return 0, false
+	}
+
+	total := 0
+	number := 0
+	digits := false
+	for _, c := range strings.ToLower(value) {
+		if unicode.IsDigit(c) {
+			number = number*10 + int(c-'0')
+			digits = true
+			continue
+		}
+		if !digits {
+			return 0, false
+		}
+		switch c {
+		case 's':
+		case 'm':
+			number *= 60
+		case 'h':
+			number *= 3600
+		case 'd':
+			number *= 86400
+		case 'w':
+			number *= 604800
+		default:
+			return 0, false
+		}
+		total += number
+		number = 0
+		digits = false
+	}
+	return total + number, true
+}
+
+// qualifyZoneName makes a zone file name absolute, @ is the origin.
+func qualifyZoneName(name, origin string) (string, error) {
+	switch {
+	case name == "@":
+		if origin == "" {
+			return "", fmt.Errorf("@ is used without an origin")
+		}
+		return origin, nil
+	case strings.HasSuffix(name, "."):
+		return name, nil
+	case origin == "":
+		return "", fmt.Errorf("relative name %s is used without an origin", name)
+	case origin == ".":
+		return name + ".", nil
+	}
+	return name + "." + origin, nil
+}
+
+// zoneFileNameFields returns the rdata fields holding domain names for each record type.
+var zoneFileNameFields = map[string][]int{
+	"CNAME": {0},
+	"DNAME": {0},
+	"NS":    {0},
+	"PTR":   {0},
+	"MX":    {1},
+	"SRV":   {3},
+}
+
+// parseZoneFile parses RFC 1035 zone file content into records. Relative names are
+// qualified with the origin, which $ORIGIN may change. SOA records are not returned
+// as they are part of the zone settings, their minimum is used as the default TTL.
+func parseZoneFile(content, origin string) ([]provisionclient.DNSRecord, error) {
+	lines, err := splitZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != "" {
+		origin = canonicalFQDN(origin)
+	}
+
+	records := []provisionclient.DNSRecord{}
+	owner := ""
+	defaultTTL := -1
+	lastTTL := -1
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if strings.HasPrefix(tokens[0], "$") && !line.blank {
+			directive := strings.ToUpper(tokens[0])
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) < 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a name", line.number)
+				}
+				origin, err = qualifyZoneName(tokens[1], origin)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %s", line.number, err)
+				}
+				origin = strings.ToLower(origin)
+			case "$TTL":
+				if len(tokens) < 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires a value", line.number)
+				}
+				ttl, ok := parseZoneTTL(tokens[1])
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid $TTL %s", line.number, tokens[1])
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: the %s directive is not supported", line.number, tokens[0])
+			}
+			continue
+		}
+
+		if !line.blank {
+			owner, err = qualifyZoneName(tokens[0], origin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: the record has no owner name", line.number)
+		}
+
+		// TTL and class may come in any order before the type
+		ttl := -1
+		for i := 0; i < 2 && len(tokens) != 0; i++ {
+			if value, ok := parseZoneTTL(tokens[0]); ok && ttl < 0 {
+				ttl = value
+				tokens = tokens[1:]
+				continue
+			}
+			switch strings.ToUpper(tokens[0]) {
+			case "IN", "CH", "HS", "CS":
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: the record has no type", line.number)
+		}
+		recordType := strings.ToUpper(tokens[0])
+		rdata := tokens[1:]
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("line %d: the %s record has no value", line.number, recordType)
+		}
+
+		if ttl >= 0 {
+			lastTTL = ttl
+		} else if defaultTTL >= 0 {
+			ttl = defaultTTL
+		} else {
+			ttl = lastTTL
+		}
+
+		if recordType == "SOA" {
+			if len(rdata) != 7 {
+				return nil, fmt.Errorf("line %d: the SOA record must have 7 fields", line.number)
+			}
+			if minimum, ok := parseZoneTTL(rdata[6]); ok && defaultTTL < 0 {
+				defaultTTL = minimum
+			}
+			continue
+		}
+
+		if ttl < 0 {
+			return nil, fmt.Errorf("line %d: the record has no TTL and no $TTL has been set", line.number)
+		}
+
+		for _, field := range zoneFileNameFields[recordType] {
+			if field >= len(rdata) {
+				continue
+			}
+			rdata[field], err = qualifyZoneName(rdata[field], origin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+		}
+
+		value := strings.Join(rdata, " ")
+		if knownDNSRecordType(recordType) {
+			if _, err := normalizeDNSRecordValue(recordType, value); err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+		}
+
+		records = append(records, provisionclient.DNSRecord{
+			RecordType:  recordType,
+			RecordHost:  strings.ToLower(owner),
+			RecordValue: value,
+			RecordTTL:   ttl,
+		})
+	}
+
+	return records, nil
+}
+
+// relativeZoneName returns the name relative to the origin as written in a zone file.
+func relativeZoneName(name, origin string) string {
+	name = canonicalFQDN(name)
+	origin = canonicalFQDN(origin)
+	if name == origin {
+		return "@"
+	}
+	if strings.HasSuffix(name, "."+origin) {
+		return strings.TrimSuffix(name, "."+origin)
+	}
+	return name
+}
+
+// renderZoneFile renders the zone and its records as zone file content. Records
+// are sorted by host, type and value so the output is stable.
+func renderZoneFile(zone provisionclient.DNSZone, records []provisionclient.DNSRecord, includeSOA bool) string {
+	origin := canonicalFQDN(zone.Name)
+
+	sorted := make([]provisionclient.DNSRecord, 0, len(records))
+	for _, record := range records {
+		if strings.EqualFold(record.RecordType, "SOA") {
+			continue
+		}
+		sorted = append(sorted, record)
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if canonicalFQDN(a.RecordHost) != canonicalFQDN(b.RecordHost) {
+			// The apex goes first, then the names in alphabetical order
+			if canonicalFQDN(a.RecordHost) == origin || canonicalFQDN(b.RecordHost) == origin {
+				return canonicalFQDN(a.RecordHost) == origin
+			}
+			return canonicalFQDN(a.RecordHost) < canonicalFQDN(b.RecordHost)
+		}
+		if !strings.EqualFold(a.RecordType, b.RecordType) {
+			return strings.ToUpper(a.RecordType) < strings.ToUpper(b.RecordType)
+		}
+		return a.RecordValue < b.RecordValue
+	})
+
+	var b strings.Builder
+	b.WriteString("$ORIGIN " + origin + "\n")
+	if zone.ZoneTTL != 0 {
+		b.WriteString("$TTL " + strconv.Itoa(zone.ZoneTTL) + "\n")
+	}
+
+	if includeSOA {
+		b.WriteString(fmt.Sprintf("@ IN SOA %s %s ( %d %d %d %d %d )\n",
+			canonicalFQDN(zone.ZoneHost), canonicalFQDN(zone.ZoneMail),
+			zone.ZoneSerial, zone.ZoneRefresh, zone.ZoneRetry, zone.ZoneExpire, zone.ZoneMinimum))
+	}
+
+	for _, record := range sorted {
+		b.WriteString(fmt.Sprintf("%s %d IN %s %s\n",
+			relativeZoneName(record.RecordHost, origin), record.RecordTTL, strings.ToUpper(record.RecordType), record.RecordValue))
+	}
+	return b.String()
+}
+
+// sameDNSRecordSet reports whether both lists hold the same records with the same TTLs.
+func sameDNSRecordSet(a, b []provisionclient.DNSRecord) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	matched := make([]bool, len(b))
+	for _, record := range a {
+		found := false
+		for j, other := range b {
+			if matched[j] || record.RecordTTL != other.RecordTTL || !sameDNSRecord(record, other) {
+				continue
+			}
+			matched[j] = true
+			found = true
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
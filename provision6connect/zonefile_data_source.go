@@ -0,0 +1,121 @@
+package provision6connect
+
+import (
+	"context"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zonefileDataSourceModel maps the data source schema data.
+type zonefileDataSourceModel struct {
+	ZoneID      types.String `tfsdk:"zone_id"`
+	ZoneName    types.String `tfsdk:"zone_name"`
+	IncludeSOA  types.Bool   `tfsdk:"include_soa"`
+	RecordCount types.Int64  `tfsdk:"record_count"`
+	Content     types.String `tfsdk:"content"`
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &zonefileDataSource{}
+	_ datasource.DataSourceWithConfigure = &zonefileDataSource{}
+)
+
+// NewZonefileDataSource is a helper function to simplify the provider implementation.
+func NewZonefileDataSource() datasource.DataSource {
+	return &zonefileDataSource{}
+}
+
+// zonefileDataSource is the data source implementation.
+type zonefileDataSource struct {
+	client *provisionclient.Client
+}
+
+// Metadata returns the data source type name.
+func (d *zonefileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zonefile"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *zonefileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Schema defines the schema for the data source.
+func (d *zonefileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Zone File Data Source rendering an existing ProVision DNS Zone and its DNS Records as RFC 1035 zone file content, for backups and diff review. " +
+			"Records are sorted by host, type and value so the content only changes with the zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone_id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone to render",
+				Required:    true,
+			},
+			"zone_name": schema.StringAttribute{
+				Description: "DNS Zone Name in FQDN format, used as $ORIGIN",
+				Computed:    true,
+			},
+			"include_soa": schema.BoolAttribute{
+				Description: "Render the SOA record from the DNS Zone settings, defaults to true",
+				Optional:    true,
+			},
+			"record_count": schema.Int64Attribute{
+				Description: "Number of DNS Records rendered, the SOA record excluded",
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "Zone file content of the DNS Zone",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *zonefileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state zonefileDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, records, err := zoneManagedRecords(&d.client.DNS, state.ZoneID.ValueString(), false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read ProVision DNS Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	includeSOA := state.IncludeSOA.IsNull() || state.IncludeSOA.ValueBool()
+	content := renderZoneFile(*zone, records, includeSOA)
+
+	count := 0
+	for _, record := range records {
+		if !strings.EqualFold(record.RecordType, "SOA") {
+			count++
+		}
+	}
+
+	state.ZoneName = types.StringValue(zone.Name)
+	state.RecordCount = types.Int64Value(int64(count))
+	state.Content = types.StringValue(content)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
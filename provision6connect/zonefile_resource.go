@@ -0,0 +1,437 @@
+package provision6connect
+
+import (
+	"context"
+	"strings"
+
+	provisionclient "github.com/6connect/golangclient"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &zonefileResource{}
+	_ resource.ResourceWithConfigure      = &zonefileResource{}
+	_ resource.ResourceWithImportState    = &zonefileResource{}
+	_ resource.ResourceWithModifyPlan     = &zonefileResource{}
+	_ resource.ResourceWithValidateConfig = &zonefileResource{}
+)
+
+// NewZonefileResource is a helper function to simplify the provider implementation.
+func NewZonefileResource() resource.Resource {
+	return &zonefileResource{}
+}
+
+// zonefileModel maps zone file schema data.
+type zonefileModel struct {
+	ID                types.String `tfsdk:"id"`
+	ZoneID            types.String `tfsdk:"zone_id"`
+	Content           types.String `tfsdk:"content"`
+	Origin            types.String `tfsdk:"origin"`
+	IgnoreApexRecords types.Bool   `tfsdk:"ignore_apex_records"`
+	RecordCount       types.Int64  `tfsdk:"record_count"`
+}
+
+// zonefileResource is the resource implementation.
+type zonefileResource struct {
+	client *provisionclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *zonefileResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*provisionclient.Client)
+}
+
+// Metadata returns the resource type name.
+func (r *zonefileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zonefile"
+}
+
+// Schema defines the schema for the resource.
+func (r *zonefileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Zone File Resource that owns every DNS Record of a ProVision DNS Zone from RFC 1035 zone file content, for Example a file exported from BIND. " +
+			"The $ORIGIN and $TTL directives, relative names, @ and parentheses are supported, $INCLUDE and $GENERATE are not. The SOA record is ignored as it is part of the zone settings. " +
+			"Records added outside of Terraform show up as a diff of content and are deleted on the next apply, so it must not be used together with provision6connect_dnsrecord, " +
+			"provision6connect_dns_recordset or provision6connect_dnszone_records on the same zone. It can be imported with the zone ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_id": schema.StringAttribute{
+				Description: "Numeric identifier of the DNS Zone whose records are managed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "Zone file content in RFC 1035 format, for Example file(\"example.com.zone\"). When records have been changed outside of Terraform it is refreshed with the zone as it is in ProVision.",
+				Required:    true,
+			},
+			"origin": schema.StringAttribute{
+				Description: "Origin used for the relative names of the zone file until a $ORIGIN directive, defaults to the DNS Zone Name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"ignore_apex_records": schema.BoolAttribute{
+				Description: "Leave the NS records of the zone apex out of the managed records, defaults to true. When it is false they must be in the zone file as well.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"record_count": schema.Int64Attribute{
+				Description: "Number of DNS Records managed from the zone file",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// zonefileOrigin is used to check the syntax of the zone file before the zone name is known.
+const zonefileOrigin = "origin.invalid."
+
+// ValidateConfig makes sure the zone file can be parsed.
+func (r *zonefileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config zonefileModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Content.IsUnknown() || config.Origin.IsUnknown() {
+		return
+	}
+
+	if !config.Origin.IsNull() {
+		if err := validateDNSName(config.Origin.ValueString(), true, false); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("origin"),
+				"Invalid Zone File Origin",
+				"origin "+err.Error(),
+			)
+			return
+		}
+	}
+
+	origin := config.Origin.ValueString()
+	if config.Origin.IsNull() {
+		origin = zonefileOrigin
+	}
+	if _, err := parseZoneFile(config.Content.ValueString(), origin); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid Zone File",
+			"The zone file could not be parsed, "+err.Error(),
+		)
+	}
+}
+
+// ModifyPlan defaults ignore_apex_records to true and origin to the zone name, then
+// makes sure every record of the zone file is inside the zone.
+func (r *zonefileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan zonefileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IgnoreApexRecords.IsUnknown() {
+		plan.IgnoreApexRecords = types.BoolValue(true)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ignore_apex_records"), plan.IgnoreApexRecords)...)
+	}
+
+	var state zonefileModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if r.client == nil || plan.ZoneID.IsUnknown() {
+		return
+	}
+
+	zones, err := r.client.DNS.GetZoneByID(plan.ZoneID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read ProVision DNS Zone",
+			"The zone file could not be checked against DNS Zone ID "+plan.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if len(zones) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone_id"),
+			"Error Finding ProVision DNS Zone",
+			"ProVision DNS Zone has not been found ID "+plan.ZoneID.ValueString(),
+		)
+		return
+	}
+
+	if plan.Origin.IsUnknown() {
+		plan.Origin = types.StringValue(zones[0].Name)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("origin"), plan.Origin)...)
+	}
+
+	if plan.Content.IsUnknown() || plan.Origin.IsUnknown() {
+		return
+	}
+
+	records, err := r.zonefileRecords(plan, zones[0].Name)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid Zone File",
+			"The zone file could not be parsed, "+err.Error(),
+		)
+		return
+	}
+
+	for _, record := range records {
+		if !dnsNameInZone(record.RecordHost, zones[0].Name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("content"),
+				"DNS Record Outside of DNS Zone",
+				"The "+record.RecordType+" record "+record.RecordHost+" is not inside the DNS Zone "+zones[0].Name+".",
+			)
+		}
+	}
+
+	// The count only changes with the content, it is known from the state otherwise
+	if state.Content.Equal(plan.Content) && state.Origin.Equal(plan.Origin) && state.IgnoreApexRecords.Equal(plan.IgnoreApexRecords) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("record_count"), types.Int64Value(int64(len(records))))...)
+}
+
+// zonefileRecords parses the zone file content of the model into the records of the
+// zone, the NS records of the zone apex are left out when they are ignored.
+func (r *zonefileResource) zonefileRecords(model zonefileModel, zoneName string) ([]provisionclient.DNSRecord, error) {
+	parsed, err := parseZoneFile(model.Content.ValueString(), model.Origin.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]provisionclient.DNSRecord, 0, len(parsed))
+	for _, record := range parsed {
+		if model.IgnoreApexRecords.ValueBool() && isApexDNSRecord(record, zoneName) {
+			continue
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// currentRecords returns the zone and the records of the zone owned by the resource,
+// the SOA record is never part of them.
+func (r *zonefileResource) currentRecords(model zonefileModel) (*provisionclient.DNSZone, []provisionclient.DNSRecord, error) {
+	zone, records, err := zoneManagedRecords(&r.client.DNS, model.ZoneID.ValueString(), model.IgnoreApexRecords.ValueBool())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	managed := make([]provisionclient.DNSRecord, 0, len(records))
+	for _, record := range records {
+		if !strings.EqualFold(record.RecordType, "SOA") {
+			managed = append(managed, record)
+		}
+	}
+	return zone, managed, nil
+}
+
+// converge adds, updates and deletes the records of the zone to match the zone file.
+func (r *zonefileResource) converge(plan *zonefileModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zone, current, err := r.currentRecords(*plan)
+	if err != nil {
+		diags.AddError(
+			"Error Reading ProVision DNS Records",
+			"Could not read the ProVision DNS Records of DNS Zone ID "+plan.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	if plan.Origin.IsUnknown() || plan.Origin.IsNull() {
+		plan.Origin = types.StringValue(zone.Name)
+	}
+
+	desired, err := r.zonefileRecords(*plan, zone.Name)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("content"),
+			"Invalid Zone File",
+			"The zone file could not be parsed, "+err.Error(),
+		)
+		return diags
+	}
+	for i := range desired {
+		desired[i].ParentID = zone.ID
+	}
+
+	if _, err := syncDNSRecords(&r.client.DNS, current, desired); err != nil {
+		diags.AddError(
+			"Error Updating ProVision DNS Zone Records",
+			"Could not update the ProVision DNS Records of DNS Zone ID "+plan.ZoneID.ValueString()+" from the zone file, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	plan.RecordCount = types.Int64Value(int64(len(desired)))
+	return diags
+}
+
+// Create a new resource
+func (r *zonefileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan zonefileModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Take over the zone records
+	resp.Diagnostics.Append(r.converge(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(plan.ZoneID.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *zonefileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and zone_id attributes
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone_id"), req.ID)...)
+}
+
+// Read resource information
+func (r *zonefileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state zonefileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.IgnoreApexRecords.IsNull() {
+		state.IgnoreApexRecords = types.BoolValue(true)
+	}
+
+	zone, records, err := r.currentRecords(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading ProVision Zone File",
+			"Could not read the ProVision DNS Records of DNS Zone ID "+state.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if state.Origin.IsNull() {
+		state.Origin = types.StringValue(zone.Name)
+	}
+
+	// The content is kept while it describes the records of the zone, otherwise the
+	// zone is rendered as it is in ProVision so the changes show up in the plan
+	desired, err := r.zonefileRecords(state, zone.Name)
+	if state.Content.IsNull() || err != nil || !sameDNSRecordSet(desired, records) {
+		state.Content = types.StringValue(renderZoneFile(*zone, records, false))
+	}
+	state.RecordCount = types.Int64Value(int64(len(records)))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *zonefileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan zonefileModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Updating DNS Zone Records of DNS Zone ID "+plan.ZoneID.ValueString()+" from the zone file")
+
+	// Update existing records
+	resp.Diagnostics.Append(r.converge(&plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *zonefileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state zonefileModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, records, err := r.currentRecords(state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision Zone File",
+			"Could not read the ProVision DNS Records of DNS Zone ID "+state.ZoneID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	declared, err := r.zonefileRecords(state, zone.Name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision Zone File",
+			"Could not parse the zone file in state, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Delete the records of the zone file, the zone itself is left to provision6connect_dnszone
+	err = deleteDeclaredDNSRecords(&r.client.DNS, state.ZoneID.ValueString(), records, declared)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting ProVision Zone File",
+			"Could not delete the ProVision DNS Records of DNS Zone ID "+state.ZoneID.ValueString()+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
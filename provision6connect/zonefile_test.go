@@ -0,0 +1,196 @@
+package provision6connect
+
+import (
+	"reflect"
+	"testing"
+
+	provisionclient "github.com/6connect/golangclient"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		origin  string
+		want    []provisionclient.DNSRecord
+		wantErr bool
+	}{
+		{
+			name:    "origin and ttl directives",
+			content: "$ORIGIN example.com.\n$TTL 1h\nwww A 192.0.2.1\n$ORIGIN sub.example.com.\n$TTL 300\n@ A 192.0.2.2\n",
+			want: []provisionclient.DNSRecord{
+				{RecordType: "A", RecordHost: "www.example.com.", RecordValue: "192.0.2.1", RecordTTL: 3600},
+				{RecordType: "A", RecordHost: "sub.example.com.", RecordValue: "192.0.2.2", RecordTTL: 300},
+			},
+		},
+		{
+			name:    "relative origin",
+			content: "$ORIGIN sub\nwww 60 IN A 192.0.2.1\n",
+			origin:  "example.com",
+			want: []provisionclient.DNSRecord{
+				{RecordType: "A", RecordHost: "www.sub.example.com.", RecordValue: "192.0.2.1", RecordTTL: 60},
+			},
+		},
+		{
+			name:    "parentheses",
+			content: "@ IN SOA ns1 hostmaster (\n  2024010101 ; serial\n  3600 900\n  604800\n  120 )\nwww A 192.0.2.1\n",
+			origin:  "example.com.",
+			want: []provisionclient.DNSRecord{
+				{RecordType: "A", RecordHost: "www.example.com.", RecordValue: "192.0.2.1", RecordTTL: 120},
+			},
+		},
+		{
+			name:    "comment inside quotes",
+			content: "txt 300 IN TXT \"v=spf1 ; not a comment\" ; a comment\n",
+			origin:  "example.com.",
+			want: []provisionclient.DNSRecord{
+				{RecordType: "TXT", RecordHost: "txt.example.com.", RecordValue: "\"v=spf1 ; not a comment\"", RecordTTL: 300},
+			},
+		},
+		{
+			name:    "blank owner",
+			content: "$TTL 300\nwww A 192.0.2.1\n    A 192.0.2.2\n\t600 IN AAAA 2001:db8::1\n",
+			origin:  "example.com.",
+			want: []provisionclient.DNSRecord{
+				{RecordType: "A", RecordHost: "www.example.com.", RecordValue: "192.0.2.1", RecordTTL: 300},
+				{RecordType: "A", RecordHost: "www.example.com.", RecordValue: "192.0.2.2", RecordTTL: 300},
+				{RecordType: "AAAA", RecordHost: "www.example.com.", RecordValue: "2001:db8::1", RecordTTL: 600},
+			},
+		},
+		{
+			name:    "relative mx and srv targets",
+			content: "$TTL 300\n@ MX 10 mail\n_sip._tcp SRV 10 5 5060 sip\nalias CNAME other.example.net.\n",
+			origin:  "example.com.",
+			want: []provisionclient.DNSRecord{
+				{RecordType: "MX", RecordHost: "example.com.", RecordValue: "10 mail.example.com.", RecordTTL: 300},
+				{RecordType: "SRV", RecordHost: "_sip._tcp.example.com.", RecordValue: "10 5 5060 sip.example.com.", RecordTTL: 300},
+				{RecordType: "CNAME", RecordHost: "alias.example.com.", RecordValue: "other.example.net.", RecordTTL: 300},
+			},
+		},
+		{
+			name:    "blank owner without previous record",
+			content: "$TTL 300\n    A 192.0.2.1\n",
+			origin:  "example.com.",
+			wantErr: true,
+		},
+		{
+			name:    "relative name without origin",
+			content: "www 300 A 192.0.2.1\n",
+			wantErr: true,
+		},
+		{
+			name:    "missing ttl",
+			content: "www A 192.0.2.1\n",
+			origin:  "example.com.",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated parentheses",
+			content: "www 300 A ( 192.0.2.1\n",
+			origin:  "example.com.",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported directive",
+			content: "$INCLUDE other.zone\n",
+			origin:  "example.com.",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseZoneFile(test.content, test.origin)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseZoneTTL(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+		ok    bool
+	}{
+		{"300", 300, true},
+		{"1h30m", 5400, true},
+		{"1W", 604800, true},
+		{"2d", 172800, true},
+		{"IN", 0, false},
+		{"h", 0, false},
+		{"1x", 0, false},
+		{"", 0, false},
+	}
+
+	for _, test := range tests {
+		got, ok := parseZoneTTL(test.value)
+		if got != test.want || ok != test.ok {
+			t.Errorf("parseZoneTTL(%q) = %d, %t, want %d, %t", test.value, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestRenderZoneFileRoundTrip(t *testing.T) {
+	zone := provisionclient.DNSZone{
+		Name:        "example.com",
+		ZoneHost:    "ns1.example.com",
+		ZoneMail:    "hostmaster.example.com",
+		ZoneSerial:  2024010101,
+		ZoneRefresh: 3600,
+		ZoneRetry:   900,
+		ZoneExpire:  604800,
+		ZoneMinimum: 120,
+		ZoneTTL:     3600,
+	}
+	records := []provisionclient.DNSRecord{
+		{RecordType: "A", RecordHost: "www.example.com.", RecordValue: "192.0.2.1", RecordTTL: 300},
+		{RecordType: "AAAA", RecordHost: "example.com.", RecordValue: "2001:db8::1", RecordTTL: 3600},
+		{RecordType: "MX", RecordHost: "example.com.", RecordValue: "10 mail.example.com.", RecordTTL: 3600},
+		{RecordType: "SRV", RecordHost: "_sip._tcp.example.com.", RecordValue: "10 5 5060 sip.example.com.", RecordTTL: 600},
+		{RecordType: "TXT", RecordHost: "example.com.", RecordValue: "\"v=spf1 ; -all\"", RecordTTL: 3600},
+		{RecordType: "CNAME", RecordHost: "alias.example.com.", RecordValue: "other.example.net.", RecordTTL: 60},
+	}
+
+	for _, includeSOA := range []bool{true, false} {
+		content := renderZoneFile(zone, records, includeSOA)
+		parsed, err := parseZoneFile(content, "")
+		if err != nil {
+			t.Fatalf("parsing the rendered zone file: %s\n%s", err, content)
+		}
+		if !sameDNSRecordSet(records, parsed) {
+			t.Errorf("round-trip changed the records, got %v\n%s", parsed, content)
+		}
+	}
+}
+
+func TestSameDNSRecordSet(t *testing.T) {
+	a := []provisionclient.DNSRecord{
+		{RecordType: "A", RecordHost: "www.example.com.", RecordValue: "192.0.2.1", RecordTTL: 300},
+		{RecordType: "A", RecordHost: "www.example.com.", RecordValue: "192.0.2.2", RecordTTL: 300},
+	}
+	reordered := []provisionclient.DNSRecord{a[1], a[0]}
+	if !sameDNSRecordSet(a, reordered) {
+		t.Errorf("the order of the records should not matter")
+	}
+
+	ttl := []provisionclient.DNSRecord{a[0], a[1]}
+	ttl[1].RecordTTL = 600
+	if sameDNSRecordSet(a, ttl) {
+		t.Errorf("a different TTL should not match")
+	}
+
+	if sameDNSRecordSet(a, a[:1]) {
+		t.Errorf("a missing record should not match")
+	}
+}